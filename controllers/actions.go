@@ -145,7 +145,11 @@ func (r *ClusterGroupUpgradeReconciler) manageClusterLabelsAndAnnotations(ctx co
 func (r *ClusterGroupUpgradeReconciler) deleteResources(
 	ctx context.Context, clusterGroupUpgrade *ranv1alpha1.ClusterGroupUpgrade) error {
 	var targetNamespaces []string
-	for _, policy := range clusterGroupUpgrade.Status.ManagedPoliciesForUpgrade {
+	policies := append([]ranv1alpha1.ManagedPolicyForUpgrade{}, clusterGroupUpgrade.Status.ManagedPoliciesForUpgrade...)
+	if clusterGroupUpgrade.Status.Rollback != nil {
+		policies = append(policies, clusterGroupUpgrade.Status.Rollback.Policies...)
+	}
+	for _, policy := range policies {
 		if _, ok := utils.FindStringInSlice(targetNamespaces, policy.Namespace); !ok {
 			targetNamespaces = append(targetNamespaces, policy.Namespace)
 		}
@@ -21,12 +21,14 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
+	"reflect"
 	"sort"
 	"strconv"
 	"strings"
 	"time"
 
 	"github.com/go-logr/logr"
+	coordinationv1 "k8s.io/api/coordination/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -58,6 +60,8 @@ type ClusterGroupUpgradeReconciler struct {
 	Log          logr.Logger
 	Scheme       *runtime.Scheme
 	EventEmitter *Emitter
+	// Notifier sends the events to the UpgradeNotificationEndpoints, it must be added to the manager
+	Notifier *Notifier
 }
 
 type policiesInfo struct {
@@ -105,10 +109,12 @@ func requeueWithError(err error) (ctrl.Result, error) {
 //+kubebuilder:rbac:groups=ran.openshift.io,resources=precachingconfigs,verbs=get;list;watch;create;update;patch;delete
 //+kubebuilder:rbac:groups=ran.openshift.io,resources=precachingconfigs/status,verbs=get;update;patch
 //+kubebuilder:rbac:groups=ran.openshift.io,resources=precachingconfigs/finalizers,verbs=update
+//+kubebuilder:rbac:groups=ran.openshift.io,resources=upgradeconcurrencypolicies,verbs=get;list;watch
 //+kubebuilder:rbac:groups=cluster.open-cluster-management.io,resources=placements,verbs=get;list;watch;create;update;patch;delete;deletecollection
 //+kubebuilder:rbac:groups=policy.open-cluster-management.io,resources=placementbindings,verbs=get;list;watch;create;update;patch;delete;deletecollection
 //+kubebuilder:rbac:groups=policy.open-cluster-management.io,resources=policies,verbs=get;list;watch;create;update;patch;delete
 //+kubebuilder:rbac:groups=cluster.open-cluster-management.io,resources=managedclusters,verbs=get;list;watch;update;patch
+//+kubebuilder:rbac:groups=coordination.k8s.io,resources=leases,verbs=get;list;watch
 //+kubebuilder:rbac:groups=action.open-cluster-management.io,resources=managedclusteractions,verbs=create;update;delete;get;list;watch;patch;deletecollection
 //+kubebuilder:rbac:groups=view.open-cluster-management.io,resources=managedclusterviews,verbs=create;update;delete;get;list;watch;patch;deletecollection
 //+kubebuilder:rbac:groups=work.open-cluster-management.io,resources=manifestworks,verbs=create;update;delete;get;list;watch;patch;deletecollection
@@ -145,6 +151,7 @@ func (r *ClusterGroupUpgradeReconciler) Reconcile(ctx context.Context, req ctrl.
 	err = r.Get(ctx, req.NamespacedName, clusterGroupUpgrade)
 	if err != nil {
 		if errors.IsNotFound(err) {
+			deleteCGUMetrics(req.Namespace, req.Name)
 			err = nil
 			return
 		}
@@ -152,6 +159,13 @@ func (r *ClusterGroupUpgradeReconciler) Reconcile(ctx context.Context, req ctrl.
 		return
 	}
 
+	ctx = withMetricsObservations(ctx)
+	ctx, span := utils.StartCGUSpan(ctx, "Reconcile", clusterGroupUpgrade)
+	defer func() {
+		span.SetAttributes(utils.TraceAttributeBatchIndex.Int(clusterGroupUpgrade.Status.Status.CurrentBatch))
+		utils.EndSpan(span, err)
+	}()
+
 	r.Log.Info("Loaded CGU", "name", req.NamespacedName, "version", clusterGroupUpgrade.GetResourceVersion())
 	var reconcileTime int
 	reconcileTime, err = r.handleCguFinalizer(ctx, clusterGroupUpgrade)
@@ -166,6 +180,7 @@ func (r *ClusterGroupUpgradeReconciler) Reconcile(ctx context.Context, req ctrl.
 	case utils.StopReconciling:
 		return
 	}
+	useInheritedRollout(clusterGroupUpgrade)
 
 	suceededCondition := meta.FindStatusCondition(clusterGroupUpgrade.Status.Conditions, string(utils.ConditionTypes.Succeeded))
 	progressingCondition := meta.FindStatusCondition(clusterGroupUpgrade.Status.Conditions, string(utils.ConditionTypes.Progressing))
@@ -184,6 +199,7 @@ func (r *ClusterGroupUpgradeReconciler) Reconcile(ctx context.Context, req ctrl.
 				r.sendEventCGUSuccess(ctx, clusterGroupUpgrade)
 			} else {
 				r.sendEventCGUTimedout(ctx, clusterGroupUpgrade)
+				observeCGUTimedout(ctx, clusterGroupUpgrade)
 			}
 			// Set completion time only after post actions are executed with no errors
 			clusterGroupUpgrade.Status.Status.CompletedAt = metav1.Now()
@@ -265,7 +281,22 @@ func (r *ClusterGroupUpgradeReconciler) Reconcile(ctx context.Context, req ctrl.
 			)
 
 			// Build the upgrade batches.
-			compliantClusters = r.buildRemediationPlan(clusterGroupUpgrade, clusters, managedPoliciesInfo.presentPolicies)
+			compliantClusters, err = r.buildRemediationPlan(ctx, clusterGroupUpgrade, clusters, managedPoliciesInfo.presentPolicies)
+			if err != nil {
+				return
+			}
+
+			// In dry run mode, report the plan and stop before creating any resources.
+			if clusterGroupUpgrade.Spec.DryRun {
+				err = r.buildDryRunPlan(clusterGroupUpgrade, clusters, compliantClusters, managedPoliciesInfo.presentPolicies)
+				if err != nil {
+					return
+				}
+				nextReconcile = requeueWithLongInterval()
+				err = r.updateStatus(ctx, clusterGroupUpgrade)
+				return
+			}
+			clusterGroupUpgrade.Status.DryRun = nil
 
 			// Recheck clusters list for any changes to the plan
 			clusters = utils.GetClustersListFromRemediationPlan(clusterGroupUpgrade)
@@ -393,6 +424,19 @@ func (r *ClusterGroupUpgradeReconciler) Reconcile(ctx context.Context, req ctrl.
 			return
 		}
 
+		if startIn := scheduledStartDelay(clusterGroupUpgrade); startIn > 0 {
+			utils.SetStatusCondition(
+				&clusterGroupUpgrade.Status.Conditions,
+				utils.ConditionTypes.Progressing,
+				utils.ConditionReasons.Scheduled,
+				metav1.ConditionFalse,
+				fmt.Sprintf("Scheduled to start at %s", clusterGroupUpgrade.Spec.StartAt.UTC().Format(time.RFC3339)),
+			)
+			nextReconcile = requeueWithCustomInterval(startIn)
+			err = r.updateStatus(ctx, clusterGroupUpgrade)
+			return
+		}
+
 		r.sendEventCGUStarted(ctx, clusterGroupUpgrade)
 
 		if clusterGroupUpgrade.Status.Status.StartedAt.IsZero() {
@@ -404,6 +448,14 @@ func (r *ClusterGroupUpgradeReconciler) Reconcile(ctx context.Context, req ctrl.
 		if err != nil {
 			return
 		}
+		// Check if there are other active CRs remediating the same clusters that have to go first.
+		var conflictingCRs []string
+		if clusterGroupUpgrade.Spec.ConflictAction != ranv1alpha1.ConflictAction.WaitForOverlappingClusters {
+			conflictingCRs, err = r.getConflictingCRs(ctx, clusterGroupUpgrade)
+			if err != nil {
+				return
+			}
+		}
 
 		// nolint: gocritic
 		if len(blockingCRsMissing) > 0 {
@@ -426,6 +478,16 @@ func (r *ClusterGroupUpgradeReconciler) Reconcile(ctx context.Context, req ctrl.
 				fmt.Sprintf("Blocking CRs that are not completed: %s", blockingCRsNotCompleted),
 			)
 			nextReconcile = requeueWithMediumInterval()
+		} else if len(conflictingCRs) > 0 {
+			// Other CRs remediating the same clusters go first
+			utils.SetStatusCondition(
+				&clusterGroupUpgrade.Status.Conditions,
+				utils.ConditionTypes.Progressing,
+				utils.ConditionReasons.WaitingForConflictingCR,
+				metav1.ConditionFalse,
+				fmt.Sprintf("Waiting for conflicting CRs remediating the same clusters: %s", conflictingCRs),
+			)
+			nextReconcile = requeueWithMediumInterval()
 		} else {
 			err = r.reconcileBackup(ctx, clusterGroupUpgrade, clusters)
 			if err != nil {
@@ -503,7 +565,11 @@ func (r *ClusterGroupUpgradeReconciler) Reconcile(ctx context.Context, req ctrl.
 			}
 
 			// Rebuild remediation plan since we are about to start the upgrade and want to make sure the non-successful clusters were filtered out
-			newCompliantClustesrs := r.buildRemediationPlan(clusterGroupUpgrade, clusters, managedPoliciesInfo.presentPolicies)
+			var newCompliantClustesrs []string
+			newCompliantClustesrs, err = r.buildRemediationPlan(ctx, clusterGroupUpgrade, clusters, managedPoliciesInfo.presentPolicies)
+			if err != nil {
+				return
+			}
 			compliantClusters = append(compliantClusters, newCompliantClustesrs...)
 			err = r.performAfterCompletionActions(ctx, clusterGroupUpgrade, compliantClusters)
 			if err != nil {
@@ -552,11 +618,49 @@ func (r *ClusterGroupUpgradeReconciler) Reconcile(ctx context.Context, req ctrl.
 	} else {
 		r.Log.Info("[Reconcile]", "Status.CurrentBatch", clusterGroupUpgrade.Status.Status.CurrentBatch)
 
+		// Disabling an in-progress CGU pauses it: nothing new is remediated until it is re-enabled.
+		if !*clusterGroupUpgrade.Spec.Enable {
+			r.pauseUpgrade(ctx, clusterGroupUpgrade)
+			nextReconcile = requeueWithLongInterval()
+			err = r.updateStatus(ctx, clusterGroupUpgrade)
+			return
+		}
+		if clusterGroupUpgrade.Status.Status.PausedAt != nil {
+			r.resumeUpgrade(ctx, clusterGroupUpgrade)
+		}
+
 		// If the upgrade is just starting, set the batch to be shown in the Status as 1.
 		if clusterGroupUpgrade.Status.Status.CurrentBatch == 0 {
 			clusterGroupUpgrade.Status.Status.CurrentBatch = 1
 		}
 
+		// After a canary failure, only the rollback of the canaries is left to do
+		if clusterGroupUpgrade.Status.Rollback != nil {
+			err = r.reconcileCanaryRollback(ctx, clusterGroupUpgrade)
+			if err != nil {
+				return
+			}
+			nextReconcile = requeueWithShortInterval()
+			err = r.updateStatus(ctx, clusterGroupUpgrade)
+			return
+		}
+
+		if clusterGroupUpgrade.Spec.DynamicMembership {
+			err = r.updateDynamicMembership(ctx, clusterGroupUpgrade)
+			if err != nil {
+				return
+			}
+		}
+
+		// New batches only start inside the maintenance windows
+		if clusterGroupUpgrade.Status.Status.CurrentBatchStartedAt.IsZero() {
+			if waitFor, waiting := r.waitForMaintenanceWindow(clusterGroupUpgrade); waiting {
+				nextReconcile = requeueWithCustomInterval(waitFor)
+				err = r.updateStatus(ctx, clusterGroupUpgrade)
+				return
+			}
+		}
+
 		//nolint
 		requeueAfter := time.Until(clusterGroupUpgrade.Status.Status.CurrentBatchStartedAt.Add(5 * time.Minute))
 		if requeueAfter < 0 {
@@ -567,6 +671,12 @@ func (r *ClusterGroupUpgradeReconciler) Reconcile(ctx context.Context, req ctrl.
 		// At first, assume all clusters in the batch start applying policies starting with the first one.
 		// Also set the start time of the current batch to the current timestamp.
 		if clusterGroupUpgrade.Status.Status.CurrentBatchStartedAt.IsZero() {
+			if clusterGroupUpgrade.Spec.RemediationStrategy.UnavailableClusters != nil {
+				err = r.handleUnavailableClusters(ctx, clusterGroupUpgrade)
+				if err != nil {
+					return
+				}
+			}
 			r.initializeBatchProgress(clusterGroupUpgrade)
 			if shouldDeleteObjects(clusterGroupUpgrade) {
 				err = r.cleanupManifestWorkForPreviousBatch(ctx, clusterGroupUpgrade)
@@ -608,7 +718,16 @@ func (r *ClusterGroupUpgradeReconciler) Reconcile(ctx context.Context, req ctrl.
 				return
 			}
 
-			if isBatchComplete {
+			// Clusters may have timed out individually
+			var isStopped bool
+			isStopped, err = r.handleClusterFailures(ctx, clusterGroupUpgrade)
+			if err != nil {
+				return
+			}
+
+			if isStopped {
+				nextReconcile = requeueImmediately()
+			} else if isBatchComplete {
 				// If the upgrade is completed for the current batch, cleanup and move to the next.
 				r.Log.Info("[Reconcile] Upgrade completed for batch", "batchIndex", clusterGroupUpgrade.Status.Status.CurrentBatch)
 				if clusterGroupUpgrade.RolloutType() == ranv1alpha1.RolloutTypes.Policy {
@@ -661,12 +780,23 @@ func (r *ClusterGroupUpgradeReconciler) Reconcile(ctx context.Context, req ctrl.
 				// Check if this batch has timed out
 				if !clusterGroupUpgrade.Status.Status.CurrentBatchStartedAt.IsZero() {
 
-					currentBatchTimeout := utils.CalculateBatchTimeout(
-						clusterGroupUpgrade.Spec.RemediationStrategy.Timeout,
-						len(clusterGroupUpgrade.Status.RemediationPlan),
-						clusterGroupUpgrade.Status.Status.CurrentBatch,
-						clusterGroupUpgrade.Status.Status.CurrentBatchStartedAt.Time,
-						clusterGroupUpgrade.Status.Status.StartedAt.Time)
+					var currentBatchTimeout time.Duration
+					if len(clusterGroupUpgrade.Spec.RemediationStrategy.BatchSizes) > 0 {
+						// Progressive batches have different sizes, give the bigger ones more time
+						currentBatchTimeout = utils.CalculateWeightedBatchTimeout(
+							clusterGroupUpgrade.Spec.RemediationStrategy.Timeout,
+							clusterGroupUpgrade.Status.RemediationPlan,
+							clusterGroupUpgrade.Status.Status.CurrentBatch,
+							clusterGroupUpgrade.Status.Status.CurrentBatchStartedAt.Time,
+							clusterGroupUpgrade.Status.Status.StartedAt.Time)
+					} else {
+						currentBatchTimeout = utils.CalculateBatchTimeout(
+							clusterGroupUpgrade.Spec.RemediationStrategy.Timeout,
+							len(clusterGroupUpgrade.Status.RemediationPlan),
+							clusterGroupUpgrade.Status.Status.CurrentBatch,
+							clusterGroupUpgrade.Status.Status.CurrentBatchStartedAt.Time,
+							clusterGroupUpgrade.Status.Status.StartedAt.Time)
+					}
 
 					r.Log.Info("[Reconcile] Calculating batch timeout (minutes)", "currentBatchTimeout", fmt.Sprintf("%f", currentBatchTimeout.Minutes()))
 
@@ -678,20 +808,10 @@ func (r *ClusterGroupUpgradeReconciler) Reconcile(ctx context.Context, req ctrl.
 						if len(clusterGroupUpgrade.Spec.RemediationStrategy.Canaries) != 0 &&
 							clusterGroupUpgrade.Status.Status.CurrentBatch <= len(clusterGroupUpgrade.Spec.RemediationStrategy.Canaries) {
 							r.Log.Info("Canaries batch timed out")
-							utils.SetStatusCondition(
-								&clusterGroupUpgrade.Status.Conditions,
-								utils.ConditionTypes.Progressing,
-								utils.ConditionReasons.TimedOut,
-								metav1.ConditionFalse,
-								utils.TimeoutMessages[clusterGroupUpgrade.RolloutType()]+" on canary clusters",
-							)
-							utils.SetStatusCondition(
-								&clusterGroupUpgrade.Status.Conditions,
-								utils.ConditionTypes.Succeeded,
-								utils.ConditionReasons.TimedOut,
-								metav1.ConditionFalse,
-								utils.TimeoutMessages[clusterGroupUpgrade.RolloutType()]+" on canary clusters",
-							)
+							err = r.handleCanaryFailure(ctx, clusterGroupUpgrade)
+							if err != nil {
+								return
+							}
 						} else {
 							r.Log.Info("Batch upgrade timed out")
 							err = r.handleBatchTimeout(ctx, clusterGroupUpgrade)
@@ -717,7 +837,11 @@ func (r *ClusterGroupUpgradeReconciler) Reconcile(ctx context.Context, req ctrl.
 									utils.TimeoutMessages[clusterGroupUpgrade.RolloutType()]+" on some clusters",
 								)
 							default:
-								// If the value was continue or not defined then continue
+								// If the value was continue or not defined then continue, unless too many clusters have failed
+								if utils.IsFailureThresholdExceeded(clusterGroupUpgrade) {
+									r.stopOnFailureThreshold(clusterGroupUpgrade)
+									break
+								}
 								clusterGroupUpgrade.Status.Status.CurrentBatchStartedAt = metav1.Time{}
 								if clusterGroupUpgrade.Status.Status.CurrentBatch < len(clusterGroupUpgrade.Status.RemediationPlan) {
 									clusterGroupUpgrade.Status.Status.CurrentBatch++
@@ -734,7 +858,14 @@ func (r *ClusterGroupUpgradeReconciler) Reconcile(ctx context.Context, req ctrl.
 			if err != nil {
 				return
 			}
-			if isUpgradeComplete {
+			var isStopped bool
+			isStopped, err = r.handleClusterFailures(ctx, clusterGroupUpgrade)
+			if err != nil {
+				return
+			}
+			if isStopped {
+				nextReconcile = requeueImmediately()
+			} else if isUpgradeComplete {
 				utils.SetStatusCondition(
 					&clusterGroupUpgrade.Status.Conditions,
 					utils.ConditionTypes.Progressing,
@@ -752,6 +883,10 @@ func (r *ClusterGroupUpgradeReconciler) Reconcile(ctx context.Context, req ctrl.
 				nextReconcile = requeueImmediately()
 			}
 		}
+
+		if clusterGroupUpgrade.Spec.RemediationStrategy.Mode == ranv1alpha1.RemediationMode.Rolling {
+			updateRollingStatus(clusterGroupUpgrade)
+		}
 	}
 
 	// Update status
@@ -767,6 +902,605 @@ func shouldDeleteObjects(clusterGroupUpgrade *ranv1alpha1.ClusterGroupUpgrade) b
 	return false
 }
 
+// scheduledStartDelay returns how long is left until the scheduled start of the CGU, if any
+func scheduledStartDelay(clusterGroupUpgrade *ranv1alpha1.ClusterGroupUpgrade) time.Duration {
+	if clusterGroupUpgrade.Spec.StartAt == nil {
+		return 0
+	}
+	return time.Until(clusterGroupUpgrade.Spec.StartAt.Time)
+}
+
+// stopOnFailureThreshold stops the upgrade once more clusters have failed than allowed by maxFailures
+func (r *ClusterGroupUpgradeReconciler) stopOnFailureThreshold(clusterGroupUpgrade *ranv1alpha1.ClusterGroupUpgrade) {
+	failedClusters := utils.GetFailedClusters(clusterGroupUpgrade)
+	r.Log.Info("[stopOnFailureThreshold] Failure threshold exceeded", "failedClusters", failedClusters,
+		"maxFailures", clusterGroupUpgrade.Spec.RemediationStrategy.MaxFailures.String())
+	message := fmt.Sprintf("Stopped after %d clusters failed, exceeding maxFailures %s",
+		len(failedClusters), clusterGroupUpgrade.Spec.RemediationStrategy.MaxFailures.String())
+	utils.SetStatusCondition(
+		&clusterGroupUpgrade.Status.Conditions,
+		utils.ConditionTypes.Progressing,
+		utils.ConditionReasons.FailureThresholdExceeded,
+		metav1.ConditionFalse,
+		message,
+	)
+	utils.SetStatusCondition(
+		&clusterGroupUpgrade.Status.Conditions,
+		utils.ConditionTypes.Succeeded,
+		utils.ConditionReasons.FailureThresholdExceeded,
+		metav1.ConditionFalse,
+		message,
+	)
+}
+
+// pauseUpgrade records the time the in-progress upgrade was paused and reflects it in the Progressing condition.
+func (r *ClusterGroupUpgradeReconciler) pauseUpgrade(ctx context.Context, clusterGroupUpgrade *ranv1alpha1.ClusterGroupUpgrade) {
+	if clusterGroupUpgrade.Status.Status.PausedAt != nil {
+		return
+	}
+	r.Log.Info("[pauseUpgrade] Pausing upgrade", "currentBatch", clusterGroupUpgrade.Status.Status.CurrentBatch)
+	now := metav1.Now()
+	clusterGroupUpgrade.Status.Status.PausedAt = &now
+	utils.SetStatusCondition(
+		&clusterGroupUpgrade.Status.Conditions,
+		utils.ConditionTypes.Progressing,
+		utils.ConditionReasons.Paused,
+		metav1.ConditionTrue,
+		"Paused: no new batches or clusters are remediated until enable is set to true",
+	)
+	r.sendEventCGUPaused(ctx, clusterGroupUpgrade)
+}
+
+// resumeUpgrade continues a paused upgrade. The time spent paused is not counted against
+// the CGU and current batch timeouts, so the start timestamps are shifted by that amount.
+func (r *ClusterGroupUpgradeReconciler) resumeUpgrade(ctx context.Context, clusterGroupUpgrade *ranv1alpha1.ClusterGroupUpgrade) {
+	pausedFor := time.Since(clusterGroupUpgrade.Status.Status.PausedAt.Time)
+	r.Log.Info("[resumeUpgrade] Resuming upgrade", "pausedFor", pausedFor.String())
+	shiftUpgradeClock(clusterGroupUpgrade, pausedFor)
+	if waitingSince := clusterGroupUpgrade.Status.Status.WaitingForMaintenanceWindowSince; waitingSince != nil {
+		// Don't count the pause twice once the maintenance window opens
+		clusterGroupUpgrade.Status.Status.WaitingForMaintenanceWindowSince = &metav1.Time{Time: waitingSince.Add(pausedFor)}
+	}
+	clusterGroupUpgrade.Status.Status.PausedAt = nil
+	utils.SetStatusCondition(
+		&clusterGroupUpgrade.Status.Conditions,
+		utils.ConditionTypes.Progressing,
+		utils.ConditionReasons.InProgress,
+		metav1.ConditionTrue,
+		utils.InProgressMessages[clusterGroupUpgrade.RolloutType()],
+	)
+	r.sendEventCGUResumed(ctx, clusterGroupUpgrade)
+}
+
+// waitForMaintenanceWindow checks whether the next batch has to wait for a maintenance window to open, and for how long.
+// The time spent waiting is not counted against the timeouts.
+func (r *ClusterGroupUpgradeReconciler) waitForMaintenanceWindow(clusterGroupUpgrade *ranv1alpha1.ClusterGroupUpgrade) (time.Duration, bool) {
+	inWindow, nextOpening := utils.IsInMaintenanceWindow(clusterGroupUpgrade.Spec.MaintenanceWindows, time.Now())
+	waitingSince := clusterGroupUpgrade.Status.Status.WaitingForMaintenanceWindowSince
+	if inWindow {
+		if waitingSince != nil {
+			r.Log.Info("[waitForMaintenanceWindow] Maintenance window opened", "waitedFor", time.Since(waitingSince.Time).String())
+			shiftUpgradeClock(clusterGroupUpgrade, time.Since(waitingSince.Time))
+			clusterGroupUpgrade.Status.Status.WaitingForMaintenanceWindowSince = nil
+			utils.SetStatusCondition(
+				&clusterGroupUpgrade.Status.Conditions,
+				utils.ConditionTypes.Progressing,
+				utils.ConditionReasons.InProgress,
+				metav1.ConditionTrue,
+				utils.InProgressMessages[clusterGroupUpgrade.RolloutType()],
+			)
+		}
+		return 0, false
+	}
+
+	if waitingSince == nil {
+		now := metav1.Now()
+		clusterGroupUpgrade.Status.Status.WaitingForMaintenanceWindowSince = &now
+	}
+	// The windows are validated, so there is always an opening in the coming week
+	waitFor := time.Until(nextOpening)
+	utils.SetStatusCondition(
+		&clusterGroupUpgrade.Status.Conditions,
+		utils.ConditionTypes.Progressing,
+		utils.ConditionReasons.WaitingForMaintenanceWindow,
+		metav1.ConditionTrue,
+		fmt.Sprintf("Waiting for the next maintenance window at %s to start batch %d",
+			nextOpening.UTC().Format(time.RFC3339), clusterGroupUpgrade.Status.Status.CurrentBatch),
+	)
+	r.Log.Info("[waitForMaintenanceWindow] Waiting for maintenance window", "nextOpening", nextOpening, "waitFor", waitFor.String())
+	return waitFor, true
+}
+
+// isInClusterMaintenanceWindow checks the maintenance windows set in the ManagedCluster annotation, if any
+func (r *ClusterGroupUpgradeReconciler) isInClusterMaintenanceWindow(ctx context.Context, clusterName string) (bool, error) {
+	managedCluster := &clusterv1.ManagedCluster{}
+	if err := r.Get(ctx, types.NamespacedName{Name: clusterName}, managedCluster); err != nil {
+		// A missing cluster is handled by the remediation itself
+		return errors.IsNotFound(err), client.IgnoreNotFound(err)
+	}
+	annotation, ok := managedCluster.GetAnnotations()[utils.MaintenanceWindowsAnnotation]
+	if !ok {
+		return true, nil
+	}
+	windows, err := utils.ParseMaintenanceWindows(annotation)
+	if err != nil {
+		// Don't block the cluster forever because of a typo in its annotation
+		r.Log.Error(err, "Ignoring the cluster maintenance windows", "cluster", clusterName)
+		return true, nil
+	}
+	inWindow, _ := utils.IsInMaintenanceWindow(windows, time.Now())
+	return inWindow, nil
+}
+
+// clusterStartState holds what canStartClusterRemediation reads from the other resources. It is read for the first
+// cluster of the batch waiting to start and shared by the next ones, so that it is read once per reconciliation.
+type clusterStartState struct {
+	// Conflicting ClusterGroupUpgrades by cluster
+	conflicts   map[string][]string
+	concurrency *concurrencyState
+}
+
+// canStartClusterRemediation checks whether the remediation of a cluster of the current batch can start
+func (r *ClusterGroupUpgradeReconciler) canStartClusterRemediation(
+	ctx context.Context, clusterGroupUpgrade *ranv1alpha1.ClusterGroupUpgrade, clusterName string,
+	startState *clusterStartState) (bool, error) {
+	if clusterGroupUpgrade.Spec.RemediationStrategy.Mode == ranv1alpha1.RemediationMode.Rolling &&
+		len(getInFlightClusters(clusterGroupUpgrade)) >= clusterGroupUpgrade.Status.ComputedMaxConcurrency {
+		return false, nil
+	}
+	inWindow, err := r.isInClusterMaintenanceWindow(ctx, clusterName)
+	if err != nil || !inWindow {
+		return false, err
+	}
+	// Another ClusterGroupUpgrade may be remediating the cluster
+	if startState.conflicts == nil {
+		startState.conflicts, err = r.getClusterConflicts(ctx, clusterGroupUpgrade)
+		if err != nil {
+			return false, err
+		}
+	}
+	waitingMsg := getClusterConflictMessage(startState.conflicts, clusterName)
+	if waitingMsg != "" {
+		utils.SetStatusCondition(
+			&clusterGroupUpgrade.Status.Conditions,
+			utils.ConditionTypes.Progressing,
+			utils.ConditionReasons.WaitingForConflictingCR,
+			metav1.ConditionTrue,
+			waitingMsg,
+		)
+		return false, nil
+	}
+	if startState.concurrency == nil {
+		startState.concurrency, err = r.getConcurrencyState(ctx, clusterGroupUpgrade)
+		if err != nil {
+			return false, err
+		}
+	}
+	waitingMsg = r.getConcurrencySlotMessage(clusterGroupUpgrade, clusterName, startState.concurrency)
+	if waitingMsg != "" {
+		utils.SetStatusCondition(
+			&clusterGroupUpgrade.Status.Conditions,
+			utils.ConditionTypes.Progressing,
+			utils.ConditionReasons.WaitingForConcurrencySlot,
+			metav1.ConditionTrue,
+			waitingMsg,
+		)
+		return false, nil
+	}
+	if clusterGroupUpgrade.Spec.PreflightChecks != nil {
+		passed, err := r.runPreflightChecks(ctx, clusterGroupUpgrade, clusterName)
+		if err != nil || !passed {
+			return false, err
+		}
+	}
+	return true, nil
+}
+
+// getInFlightClusters returns the sorted clusters of the current batch whose remediation is in progress
+func getInFlightClusters(clusterGroupUpgrade *ranv1alpha1.ClusterGroupUpgrade) []string {
+	var clusters []string
+	for clusterName, clusterProgress := range clusterGroupUpgrade.Status.Status.CurrentBatchRemediationProgress {
+		if clusterProgress != nil && clusterProgress.State == ranv1alpha1.InProgress {
+			clusters = append(clusters, clusterName)
+		}
+	}
+	sort.Strings(clusters)
+	return clusters
+}
+
+// updateRollingStatus reports the in-flight, queued, completed and failed clusters of a Rolling remediation
+func updateRollingStatus(clusterGroupUpgrade *ranv1alpha1.ClusterGroupUpgrade) {
+	rolling := &ranv1alpha1.RollingStatus{InFlightClusters: getInFlightClusters(clusterGroupUpgrade)}
+	batchIndex := clusterGroupUpgrade.Status.Status.CurrentBatch - 1
+	for i, batch := range clusterGroupUpgrade.Status.RemediationPlan {
+		if i < batchIndex {
+			continue
+		}
+		for _, clusterName := range batch {
+			clusterProgress := clusterGroupUpgrade.Status.Status.CurrentBatchRemediationProgress[clusterName]
+			if i > batchIndex || clusterProgress == nil || clusterProgress.State == ranv1alpha1.NotStarted {
+				rolling.QueuedClusters++
+			}
+		}
+	}
+	for _, clusterState := range clusterGroupUpgrade.Status.Clusters {
+		switch clusterState.State {
+		case utils.ClusterRemediationComplete:
+			rolling.CompletedClusters++
+		case utils.ClusterRemediationTimedout, utils.ClusterRemediationPreflightFailed:
+			rolling.FailedClusters++
+		}
+	}
+	clusterGroupUpgrade.Status.Rolling = rolling
+}
+
+// runPreflightChecks runs the preflight checks of a cluster of the current batch. A cluster failing them is removed
+// from the remediation plan and reported with the preflightfailed state.
+func (r *ClusterGroupUpgradeReconciler) runPreflightChecks(
+	ctx context.Context, clusterGroupUpgrade *ranv1alpha1.ClusterGroupUpgrade, clusterName string) (bool, error) {
+
+	result, reason, err := utils.RunHealthChecks(ctx, r.Client, clusterGroupUpgrade, clusterName,
+		utils.PreflightCheckKind, utils.GetHealthChecks(clusterGroupUpgrade.Spec.PreflightChecks))
+	if err != nil {
+		return false, err
+	}
+
+	switch result {
+	case utils.HealthCheckPassed:
+		return true, nil
+	case utils.HealthCheckFailed:
+		r.Log.Info("[runPreflightChecks] Skipping cluster failing the preflight checks", "cluster", clusterName, "reason", reason)
+		clusterGroupUpgrade.Status.RemediationPlan = utils.RemoveFromRemediationPlan(clusterGroupUpgrade.Status.RemediationPlan,
+			clusterGroupUpgrade.Status.Status.CurrentBatch-1, map[string]bool{clusterName: true})
+		delete(clusterGroupUpgrade.Status.Status.CurrentBatchRemediationProgress, clusterName)
+		clusterGroupUpgrade.Status.Clusters = append(clusterGroupUpgrade.Status.Clusters, ranv1alpha1.ClusterState{
+			Name: clusterName, State: utils.ClusterRemediationPreflightFailed, Reason: reason})
+		r.sendEventCGUClusterPreflightFailed(ctx, clusterGroupUpgrade, clusterName, reason)
+		return false, utils.DeleteManagedClusterViews(ctx, r.Client, clusterGroupUpgrade, clusterName)
+	}
+	return false, nil
+}
+
+// runVerificationChecks runs the verification checks of a cluster that completed its remediation
+func (r *ClusterGroupUpgradeReconciler) runVerificationChecks(
+	ctx context.Context, clusterGroupUpgrade *ranv1alpha1.ClusterGroupUpgrade, clusterName string) (bool, error) {
+
+	result, reason, err := utils.RunHealthChecks(ctx, r.Client, clusterGroupUpgrade, clusterName,
+		utils.VerificationCheckKind, utils.GetHealthChecks(clusterGroupUpgrade.Spec.Verification))
+	if err != nil {
+		return false, err
+	}
+	if result == utils.HealthCheckFailed {
+		r.Log.Info("[runVerificationChecks] Cluster has not passed the verification checks yet", "cluster", clusterName, "reason", reason)
+	}
+	return result == utils.HealthCheckPassed, nil
+}
+
+// shiftUpgradeClock moves the CGU and current batch start times forward by the given duration
+// so that the time the upgrade was on hold is excluded from the timeout calculations.
+func shiftUpgradeClock(clusterGroupUpgrade *ranv1alpha1.ClusterGroupUpgrade, duration time.Duration) {
+	if !clusterGroupUpgrade.Status.Status.StartedAt.IsZero() {
+		clusterGroupUpgrade.Status.Status.StartedAt = metav1.NewTime(clusterGroupUpgrade.Status.Status.StartedAt.Add(duration))
+	}
+	if !clusterGroupUpgrade.Status.Status.CurrentBatchStartedAt.IsZero() {
+		clusterGroupUpgrade.Status.Status.CurrentBatchStartedAt = metav1.NewTime(clusterGroupUpgrade.Status.Status.CurrentBatchStartedAt.Add(duration))
+	}
+	for _, clusterProgress := range clusterGroupUpgrade.Status.Status.CurrentBatchRemediationProgress {
+		if clusterProgress == nil {
+			continue
+		}
+		if clusterProgress.StartedAt != nil {
+			startedAt := metav1.NewTime(clusterProgress.StartedAt.Add(duration))
+			clusterProgress.StartedAt = &startedAt
+		}
+		if clusterProgress.PolicyStartedAt != nil {
+			policyStartedAt := metav1.NewTime(clusterProgress.PolicyStartedAt.Add(duration))
+			clusterProgress.PolicyStartedAt = &policyStartedAt
+		}
+	}
+}
+
+// getClusterTimeoutReason returns why a cluster has been remediated for too long, either longer than the cluster
+// timeout or longer than the timeout of its current policy, or an empty string if it has not
+func getClusterTimeoutReason(clusterGroupUpgrade *ranv1alpha1.ClusterGroupUpgrade, clusterName string) string {
+	clusterProgress := clusterGroupUpgrade.Status.Status.CurrentBatchRemediationProgress[clusterName]
+	if clusterProgress == nil {
+		return ""
+	}
+
+	clusterTimeout := clusterGroupUpgrade.Spec.RemediationStrategy.ClusterTimeout
+	if clusterTimeout > 0 && clusterProgress.StartedAt != nil &&
+		time.Since(clusterProgress.StartedAt.Time) > time.Duration(clusterTimeout)*time.Minute {
+		return fmt.Sprintf("Remediation did not complete within the cluster timeout of %d minutes", clusterTimeout)
+	}
+
+	if clusterGroupUpgrade.RolloutType() != ranv1alpha1.RolloutTypes.Policy || clusterProgress.PolicyIndex == nil ||
+		clusterProgress.PolicyStartedAt == nil || *clusterProgress.PolicyIndex >= len(clusterGroupUpgrade.Status.ManagedPoliciesForUpgrade) {
+		return ""
+	}
+	policyName := clusterGroupUpgrade.Status.ManagedPoliciesForUpgrade[*clusterProgress.PolicyIndex].Name
+	override := utils.GetPolicyOverride(clusterGroupUpgrade, policyName)
+	if override != nil && override.Timeout != nil &&
+		time.Since(clusterProgress.PolicyStartedAt.Time) > time.Duration(*override.Timeout)*time.Minute {
+		return fmt.Sprintf("Policy %s was not completed within its timeout of %d minutes", policyName, *override.Timeout)
+	}
+	return ""
+}
+
+// handleClusterTimeout marks a cluster that exceeded its timeout as timed out and releases it from its batch
+func (r *ClusterGroupUpgradeReconciler) handleClusterTimeout(
+	ctx context.Context, clusterGroupUpgrade *ranv1alpha1.ClusterGroupUpgrade, clusterName, reason string) error {
+
+	r.Log.Info("[handleClusterTimeout] Cluster remediation timed out", "cluster", clusterName, "reason", reason)
+	clusterFinalState := ranv1alpha1.ClusterState{
+		Name: clusterName, State: utils.ClusterRemediationTimedout, Reason: reason}
+	observeClusterDone(ctx, clusterGroupUpgrade, clusterName, metricsResultTimedout)
+
+	switch clusterGroupUpgrade.RolloutType() {
+	case ranv1alpha1.RolloutTypes.Policy:
+		r.handlePolicyTimeoutForCluster(clusterGroupUpgrade, clusterName, &clusterFinalState)
+		if err := r.removeClusterFromPlacements(ctx, clusterGroupUpgrade, clusterName); err != nil {
+			return err
+		}
+	default:
+		if err := r.handleManifestWorkTimeoutForCluster(ctx, clusterGroupUpgrade, clusterName, &clusterFinalState); err != nil {
+			return err
+		}
+		if err := utils.CleanupManifestWorkForCluster(ctx, r.Client, clusterGroupUpgrade, clusterName); err != nil {
+			return err
+		}
+	}
+
+	clusterGroupUpgrade.Status.RemediationPlan = utils.RemoveFromRemediationPlan(clusterGroupUpgrade.Status.RemediationPlan,
+		clusterGroupUpgrade.Status.Status.CurrentBatch-1, map[string]bool{clusterName: true})
+	delete(clusterGroupUpgrade.Status.Status.CurrentBatchRemediationProgress, clusterName)
+	clusterGroupUpgrade.Status.Clusters = append(clusterGroupUpgrade.Status.Clusters, clusterFinalState)
+	r.sendEventCGUClusterUpgradeTimedout(ctx, clusterGroupUpgrade, &clusterFinalState)
+	return utils.DeleteMultiCloudObjects(ctx, r.Client, clusterGroupUpgrade, clusterName)
+}
+
+// handleClusterFailures stops the upgrade once a canary cluster or more clusters than allowed by maxFailures have
+// timed out. It returns true if the upgrade was stopped.
+func (r *ClusterGroupUpgradeReconciler) handleClusterFailures(
+	ctx context.Context, clusterGroupUpgrade *ranv1alpha1.ClusterGroupUpgrade) (bool, error) {
+
+	failedClusters := utils.GetFailedClusters(clusterGroupUpgrade)
+	for _, canary := range clusterGroupUpgrade.Spec.RemediationStrategy.Canaries {
+		if _, failed := utils.FindStringInSlice(failedClusters, canary); failed {
+			r.Log.Info("[handleClusterFailures] Canary cluster timed out", "cluster", canary)
+			return true, r.handleCanaryFailure(ctx, clusterGroupUpgrade)
+		}
+	}
+	if utils.IsFailureThresholdExceeded(clusterGroupUpgrade) {
+		r.stopOnFailureThreshold(clusterGroupUpgrade)
+		return true, nil
+	}
+	return false, nil
+}
+
+// handleCanaryFailure rolls back the canary clusters or stops the upgrade after a canary failure
+func (r *ClusterGroupUpgradeReconciler) handleCanaryFailure(
+	ctx context.Context, clusterGroupUpgrade *ranv1alpha1.ClusterGroupUpgrade) error {
+
+	observeCanaryFailure(ctx, clusterGroupUpgrade)
+	if shouldRollbackCanaries(clusterGroupUpgrade) {
+		return r.startCanaryRollback(ctx, clusterGroupUpgrade)
+	}
+	utils.SetStatusCondition(
+		&clusterGroupUpgrade.Status.Conditions,
+		utils.ConditionTypes.Progressing,
+		utils.ConditionReasons.CanaryFailed,
+		metav1.ConditionFalse,
+		utils.TimeoutMessages[clusterGroupUpgrade.RolloutType()]+" on canary clusters",
+	)
+	utils.SetStatusCondition(
+		&clusterGroupUpgrade.Status.Conditions,
+		utils.ConditionTypes.Succeeded,
+		utils.ConditionReasons.CanaryFailed,
+		metav1.ConditionFalse,
+		utils.TimeoutMessages[clusterGroupUpgrade.RolloutType()]+" on canary clusters",
+	)
+	return nil
+}
+
+// isClusterAvailable checks that the ManagedCluster is available and, if maxLeaseAge is set, that its lease is fresh
+func (r *ClusterGroupUpgradeReconciler) isClusterAvailable(
+	ctx context.Context, clusterName string, maxLeaseAge *metav1.Duration) (bool, error) {
+
+	managedCluster := &clusterv1.ManagedCluster{}
+	if err := r.Get(ctx, types.NamespacedName{Name: clusterName}, managedCluster); err != nil {
+		if errors.IsNotFound(err) {
+			return false, nil
+		}
+		return false, err
+	}
+	if !meta.IsStatusConditionTrue(managedCluster.Status.Conditions, clusterv1.ManagedClusterConditionAvailable) {
+		return false, nil
+	}
+	if maxLeaseAge == nil {
+		return true, nil
+	}
+
+	lease := &coordinationv1.Lease{}
+	if err := r.Get(ctx, types.NamespacedName{Name: utils.ManagedClusterLeaseName, Namespace: clusterName}, lease); err != nil {
+		if errors.IsNotFound(err) {
+			return false, nil
+		}
+		return false, err
+	}
+	if lease.Spec.RenewTime == nil || time.Since(lease.Spec.RenewTime.Time) > maxLeaseAge.Duration {
+		r.Log.Info("[isClusterAvailable] Cluster lease is stale", "cluster", clusterName, "renewTime", lease.Spec.RenewTime)
+		return false, nil
+	}
+	return true, nil
+}
+
+// handleUnavailableClusters skips or defers the clusters of the current batch that are unavailable
+// before the batch starts.
+func (r *ClusterGroupUpgradeReconciler) handleUnavailableClusters(
+	ctx context.Context, clusterGroupUpgrade *ranv1alpha1.ClusterGroupUpgrade) error {
+
+	unavailableClustersSpec := clusterGroupUpgrade.Spec.RemediationStrategy.UnavailableClusters
+	batchIndex := clusterGroupUpgrade.Status.Status.CurrentBatch - 1
+	if batchIndex >= len(clusterGroupUpgrade.Status.RemediationPlan) {
+		return nil
+	}
+
+	var unavailableClusters []string
+	unavailableClustersMap := make(map[string]bool)
+	for _, cluster := range clusterGroupUpgrade.Status.RemediationPlan[batchIndex] {
+		available, err := r.isClusterAvailable(ctx, cluster, unavailableClustersSpec.MaxLeaseAge)
+		if err != nil {
+			return err
+		}
+		if !available {
+			unavailableClusters = append(unavailableClusters, cluster)
+			unavailableClustersMap[cluster] = true
+		}
+	}
+	if len(unavailableClusters) == 0 {
+		return nil
+	}
+
+	isLastBatch := batchIndex == len(clusterGroupUpgrade.Status.RemediationPlan)-1
+	clusterGroupUpgrade.Status.RemediationPlan = utils.RemoveFromRemediationPlan(
+		clusterGroupUpgrade.Status.RemediationPlan, batchIndex, unavailableClustersMap)
+
+	deferred := unavailableClustersSpec.Action == ranv1alpha1.UnavailableClustersAction.Defer && !isLastBatch
+	if deferred {
+		r.Log.Info("[handleUnavailableClusters] Deferring unavailable clusters", "clusters", unavailableClusters)
+		clusterGroupUpgrade.Status.RemediationPlan = utils.AppendToRemediationPlan(
+			clusterGroupUpgrade.Status.RemediationPlan, batchIndex+1, unavailableClusters, clusterGroupUpgrade.Status.ComputedMaxConcurrency)
+	} else {
+		r.Log.Info("[handleUnavailableClusters] Skipping unavailable clusters", "clusters", unavailableClusters)
+		for _, cluster := range unavailableClusters {
+			clusterGroupUpgrade.Status.Clusters = append(clusterGroupUpgrade.Status.Clusters,
+				ranv1alpha1.ClusterState{Name: cluster, State: utils.ClusterRemediationUnavailable})
+		}
+	}
+	r.sendEventCGUClustersUnavailable(ctx, clusterGroupUpgrade, unavailableClusters, deferred)
+	return nil
+}
+
+// updateDynamicMembership removes from the current and next batches the clusters whose ManagedCluster is deleted,
+// and appends to the next batches the newly selected clusters that need remediation.
+func (r *ClusterGroupUpgradeReconciler) updateDynamicMembership(
+	ctx context.Context, clusterGroupUpgrade *ranv1alpha1.ClusterGroupUpgrade) error {
+
+	batchIndex := clusterGroupUpgrade.Status.Status.CurrentBatch - 1
+	knownClusters := make(map[string]bool)
+	removedClusters := make(map[string]bool)
+	for i, batch := range clusterGroupUpgrade.Status.RemediationPlan {
+		for _, cluster := range batch {
+			knownClusters[cluster] = true
+			if i < batchIndex {
+				continue
+			}
+			progress := clusterGroupUpgrade.Status.Status.CurrentBatchRemediationProgress[cluster]
+			if i == batchIndex && progress != nil && progress.State == ranv1alpha1.Completed {
+				continue
+			}
+			managedCluster := &clusterv1.ManagedCluster{}
+			err := r.Get(ctx, types.NamespacedName{Name: cluster}, managedCluster)
+			if err != nil && !errors.IsNotFound(err) {
+				return err
+			}
+			if errors.IsNotFound(err) || !managedCluster.GetDeletionTimestamp().IsZero() {
+				r.Log.Info("[updateDynamicMembership] Removing deleted cluster from the remediation plan", "cluster", cluster)
+				removedClusters[cluster] = true
+				delete(clusterGroupUpgrade.Status.Status.CurrentBatchRemediationProgress, cluster)
+				clusterGroupUpgrade.Status.Clusters = append(clusterGroupUpgrade.Status.Clusters,
+					ranv1alpha1.ClusterState{Name: cluster, State: utils.ClusterRemediationRemoved})
+			}
+		}
+	}
+	if len(removedClusters) > 0 {
+		clusterGroupUpgrade.Status.RemediationPlan = utils.RemoveFromRemediationPlan(
+			clusterGroupUpgrade.Status.RemediationPlan, batchIndex, removedClusters)
+	}
+
+	// Clusters that have a final state or were handled before the start (e.g. failed pre-caching) are not new
+	for _, clusterState := range clusterGroupUpgrade.Status.Clusters {
+		knownClusters[clusterState.Name] = true
+	}
+	if clusterGroupUpgrade.Status.Precaching != nil {
+		for cluster := range clusterGroupUpgrade.Status.Precaching.Status {
+			knownClusters[cluster] = true
+		}
+	}
+	if clusterGroupUpgrade.Status.Backup != nil {
+		for cluster := range clusterGroupUpgrade.Status.Backup.Status {
+			knownClusters[cluster] = true
+		}
+	}
+
+	clusters, err := r.getAllClustersForUpgrade(ctx, clusterGroupUpgrade)
+	if err != nil {
+		return err
+	}
+	var newClusters []string
+	for _, cluster := range clusters {
+		if !knownClusters[cluster] {
+			newClusters = append(newClusters, cluster)
+		}
+	}
+	if len(newClusters) == 0 {
+		return nil
+	}
+
+	// Only the clusters that are NonCompliant with the managed policies need remediation. The others are
+	// checked again later since their compliance may not be reported yet.
+	if clusterGroupUpgrade.RolloutType() == ranv1alpha1.RolloutTypes.Policy {
+		var managedPolicies []*unstructured.Unstructured
+		for _, managedPolicyInfo := range clusterGroupUpgrade.Status.ManagedPoliciesForUpgrade {
+			managedPolicy, err := r.getPolicyByName(ctx, managedPolicyInfo.Name, managedPolicyInfo.Namespace)
+			if err != nil {
+				return err
+			}
+			managedPolicies = append(managedPolicies, managedPolicy)
+		}
+		nonCompliantClusters := r.getClustersNonCompliantWithManagedPolicies(newClusters, managedPolicies)
+		var clustersToRemediate []string
+		for _, cluster := range newClusters {
+			if nonCompliantClusters[cluster] {
+				clustersToRemediate = append(clustersToRemediate, cluster)
+			}
+		}
+		newClusters = clustersToRemediate
+	}
+	if len(newClusters) == 0 {
+		return nil
+	}
+
+	// New clusters never go to the canary batches nor to the current batch
+	canaryBatches := 0
+	for _, batch := range clusterGroupUpgrade.Status.RemediationPlan {
+		if len(batch) != 1 {
+			break
+		}
+		if _, isCanary := utils.FindStringInSlice(clusterGroupUpgrade.Spec.RemediationStrategy.Canaries, batch[0]); !isCanary {
+			break
+		}
+		canaryBatches++
+	}
+	fillFromIndex := max(batchIndex+1, canaryBatches)
+	r.Log.Info("[updateDynamicMembership] Adding new clusters to the remediation plan", "clusters", newClusters)
+	if clusterGroupUpgrade.Spec.RemediationStrategy.Mode == ranv1alpha1.RemediationMode.Rolling {
+		// Except in Rolling mode, where new clusters join the rolling batch even if it is the current one
+		lastIndex := len(clusterGroupUpgrade.Status.RemediationPlan) - 1
+		if lastIndex >= max(batchIndex, canaryBatches) {
+			clusterGroupUpgrade.Status.RemediationPlan[lastIndex] = append(
+				clusterGroupUpgrade.Status.RemediationPlan[lastIndex], newClusters...)
+		} else {
+			clusterGroupUpgrade.Status.RemediationPlan = append(clusterGroupUpgrade.Status.RemediationPlan, newClusters)
+		}
+		return nil
+	}
+	clusterGroupUpgrade.Status.RemediationPlan = utils.AppendToRemediationPlan(
+		clusterGroupUpgrade.Status.RemediationPlan, fillFromIndex, newClusters, clusterGroupUpgrade.Status.ComputedMaxConcurrency)
+	return nil
+}
+
 func (r *ClusterGroupUpgradeReconciler) handleBatchTimeout(ctx context.Context,
 	clusterGroupUpgrade *ranv1alpha1.ClusterGroupUpgrade) error {
 
@@ -795,19 +1529,23 @@ func (r *ClusterGroupUpgradeReconciler) handleBatchTimeout(ctx context.Context,
 			Name: batchClusterName, State: utils.ClusterRemediationComplete}
 		// In certain edge cases we need to be careful to avoid a nil pointer on this access
 		clusterStatus := clusterGroupUpgrade.Status.Status.CurrentBatchRemediationProgress[batchClusterName]
-		if clusterStatus == nil {
+		if clusterStatus == nil || clusterStatus.State == ranv1alpha1.NotStarted {
 			emitTimedoutEvt = true
 			// Assume the cluster timed out if the status was not defined when it should have been
-			// This implies that this batch did not even get a chance to start
+			// This implies that this batch did not even get a chance to start, or the cluster
+			// remediation was held back, e.g. waiting for its maintenance window
 			clusterFinalState.State = utils.ClusterRemediationTimedout
+			observeClusterDone(ctx, clusterGroupUpgrade, batchClusterName, metricsResultTimedout)
 			err := utils.DeleteMultiCloudObjects(ctx, r.Client, clusterGroupUpgrade, batchClusterName)
 			if err != nil {
 				return err
 			}
 			clusterGroupUpgrade.Status.Clusters = append(clusterGroupUpgrade.Status.Clusters, clusterFinalState)
+			r.sendEventCGUClusterUpgradeTimedout(ctx, clusterGroupUpgrade, &clusterFinalState)
 		} else if clusterStatus.State == ranv1alpha1.InProgress {
 			emitTimedoutEvt = true
 			clusterFinalState.State = utils.ClusterRemediationTimedout
+			observeClusterDone(ctx, clusterGroupUpgrade, batchClusterName, metricsResultTimedout)
 			switch clusterGroupUpgrade.RolloutType() {
 			case ranv1alpha1.RolloutTypes.Policy:
 				r.handlePolicyTimeoutForCluster(clusterGroupUpgrade, batchClusterName, &clusterFinalState)
@@ -822,11 +1560,13 @@ func (r *ClusterGroupUpgradeReconciler) handleBatchTimeout(ctx context.Context,
 				return err
 			}
 			clusterGroupUpgrade.Status.Clusters = append(clusterGroupUpgrade.Status.Clusters, clusterFinalState)
+			r.sendEventCGUClusterUpgradeTimedout(ctx, clusterGroupUpgrade, &clusterFinalState)
 		}
 	}
 
 	if emitTimedoutEvt {
 		r.sendEventCGUBatchUpgradeTimedout(ctx, clusterGroupUpgrade)
+		observeBatchDone(ctx, clusterGroupUpgrade, metricsResultTimedout)
 	}
 
 	return nil
@@ -856,9 +1596,23 @@ func (r *ClusterGroupUpgradeReconciler) updateCurrentBatchProgress(
 	isSoaking := false
 	isProgressing := false
 
+	// Set again below by the clusters still waiting for a concurrency slot or a conflicting CR
+	progressingCondition := meta.FindStatusCondition(clusterGroupUpgrade.Status.Conditions, string(utils.ConditionTypes.Progressing))
+	if progressingCondition != nil && (progressingCondition.Reason == string(utils.ConditionReasons.WaitingForConcurrencySlot) ||
+		progressingCondition.Reason == string(utils.ConditionReasons.WaitingForConflictingCR)) {
+		utils.SetStatusCondition(
+			&clusterGroupUpgrade.Status.Conditions,
+			utils.ConditionTypes.Progressing,
+			utils.ConditionReasons.InProgress,
+			metav1.ConditionTrue,
+			utils.InProgressMessages[clusterGroupUpgrade.RolloutType()],
+		)
+	}
+
+	startState := &clusterStartState{}
 	for _, clusterName := range clusterGroupUpgrade.Status.RemediationPlan[batchIndex] {
 
-		isClusterCompleted, soak, progressing, err := r.updateClusterProgress(ctx, clusterGroupUpgrade, clusterName)
+		isClusterCompleted, soak, progressing, err := r.updateClusterProgress(ctx, clusterGroupUpgrade, clusterName, startState)
 		if soak {
 			isSoaking = true
 		}
@@ -875,6 +1629,7 @@ func (r *ClusterGroupUpgradeReconciler) updateCurrentBatchProgress(
 
 	if isBatchComplete {
 		r.sendEventCGUBatchUpgradeSuccess(ctx, clusterGroupUpgrade)
+		observeBatchDone(ctx, clusterGroupUpgrade, metricsResultCompleted)
 	}
 
 	r.Log.Info("[updateCurrentBatchProgress]", "plan", clusterGroupUpgrade.Status.Status.CurrentBatchRemediationProgress, "isBatchComplete", isBatchComplete)
@@ -882,7 +1637,8 @@ func (r *ClusterGroupUpgradeReconciler) updateCurrentBatchProgress(
 }
 
 func (r *ClusterGroupUpgradeReconciler) updateClusterProgress(
-	ctx context.Context, clusterGroupUpgrade *ranv1alpha1.ClusterGroupUpgrade, clusterName string) (bool, bool, bool, error) {
+	ctx context.Context, clusterGroupUpgrade *ranv1alpha1.ClusterGroupUpgrade, clusterName string,
+	startState *clusterStartState) (bool, bool, bool, error) {
 	// nil check to avoid panic in edge cases
 	if clusterGroupUpgrade.Status.Status.CurrentBatchRemediationProgress == nil {
 		clusterGroupUpgrade.Status.Status.CurrentBatchRemediationProgress = make(map[string]*ranv1alpha1.ClusterRemediationProgress)
@@ -906,13 +1662,25 @@ func (r *ClusterGroupUpgradeReconciler) updateClusterProgress(
 
 	switch *clusterProgressState {
 	case ranv1alpha1.NotStarted:
+		canStart, err := r.canStartClusterRemediation(ctx, clusterGroupUpgrade, clusterName, startState)
+		if err != nil || !canStart {
+			return false, false, false, err
+		}
 		*index = new(int)
 		**index = 0
 		*clusterProgressState = ranv1alpha1.InProgress
+		startedAt := metav1.Now()
+		clusterGroupUpgrade.Status.Status.CurrentBatchRemediationProgress[clusterName].StartedAt = &startedAt
+		clusterGroupUpgrade.Status.Status.CurrentBatchRemediationProgress[clusterName].PolicyStartedAt = &startedAt
 
 		r.sendEventCGUClusterUpgradeStarted(ctx, clusterGroupUpgrade, clusterName)
 	case ranv1alpha1.Completed:
 		return true, false, false, nil
+	case ranv1alpha1.InProgress:
+		if reason := getClusterTimeoutReason(clusterGroupUpgrade, clusterName); reason != "" {
+			// The cluster no longer holds the batch back
+			return true, false, false, r.handleClusterTimeout(ctx, clusterGroupUpgrade, clusterName, reason)
+		}
 	}
 
 	currentIndex, isSoaking, err := r.getClusterProgress(ctx, clusterGroupUpgrade, clusterName, **index)
@@ -922,6 +1690,14 @@ func (r *ClusterGroupUpgradeReconciler) updateClusterProgress(
 
 	isProgressing := currentIndex > **index
 	if currentIndex >= size {
+		if clusterGroupUpgrade.Spec.Verification != nil {
+			verified, err := r.runVerificationChecks(ctx, clusterGroupUpgrade, clusterName)
+			if err != nil || !verified {
+				return false, isSoaking, false, err
+			}
+		}
+		observePolicyCompliant(ctx, clusterGroupUpgrade, clusterName)
+		observeClusterDone(ctx, clusterGroupUpgrade, clusterName, metricsResultCompleted)
 		clusterGroupUpgrade.Status.Status.CurrentBatchRemediationProgress[clusterName].PolicyIndex = nil
 		clusterGroupUpgrade.Status.Status.CurrentBatchRemediationProgress[clusterName].ManifestWorkIndex = nil
 		*clusterProgressState = ranv1alpha1.Completed
@@ -939,6 +1715,11 @@ func (r *ClusterGroupUpgradeReconciler) updateClusterProgress(
 		}
 		return true, isSoaking, isProgressing, nil
 	}
+	if isProgressing {
+		observePolicyCompliant(ctx, clusterGroupUpgrade, clusterName)
+		policyStartedAt := metav1.Now()
+		clusterGroupUpgrade.Status.Status.CurrentBatchRemediationProgress[clusterName].PolicyStartedAt = &policyStartedAt
+	}
 	**index = currentIndex
 	return false, isSoaking, isProgressing, nil
 }
@@ -1050,10 +1831,13 @@ func (r *ClusterGroupUpgradeReconciler) performAfterCompletionActions(
 	return nil
 }
 
-func (r *ClusterGroupUpgradeReconciler) buildRemediationPlan(
-	clusterGroupUpgrade *ranv1alpha1.ClusterGroupUpgrade, clusters []string, managedPolicies []*unstructured.Unstructured) []string {
+func (r *ClusterGroupUpgradeReconciler) buildRemediationPlan(ctx context.Context,
+	clusterGroupUpgrade *ranv1alpha1.ClusterGroupUpgrade, clusters []string, managedPolicies []*unstructured.Unstructured) (compliantClusters []string, err error) {
+	ctx, span := utils.StartCGUSpan(ctx, "buildRemediationPlan", clusterGroupUpgrade)
+	defer func() { utils.EndSpan(span, err) }()
+
 	var clusterMap map[string]bool
-	compliantClusters := []string{}
+	compliantClusters = []string{}
 	if len(managedPolicies) > 0 {
 		// Get all clusters from the CR that are non compliant with at least one of the managedPolicies.
 		clusterMap = r.getClustersNonCompliantWithManagedPolicies(clusters, managedPolicies)
@@ -1079,30 +1863,66 @@ func (r *ClusterGroupUpgradeReconciler) buildRemediationPlan(
 		}
 	}
 
-	var batch []string
-	clusterCount := 0
-	for i := 0; i < len(clusters); i++ {
-		cluster := clusters[i]
-		if !isCanary[cluster] {
-			if clusterMap[cluster] {
-				batch = append(batch, cluster)
-				clusterCount++
-			} else {
-				compliantClusters = append(compliantClusters, cluster)
-			}
+	var clustersToRemediate []string
+	for _, cluster := range clusters {
+		if isCanary[cluster] {
+			continue
 		}
+		if clusterMap[cluster] {
+			clustersToRemediate = append(clustersToRemediate, cluster)
+		} else {
+			compliantClusters = append(compliantClusters, cluster)
+		}
+	}
 
-		if clusterCount == clusterGroupUpgrade.Status.ComputedMaxConcurrency || i == len(clusters)-1 {
-			if len(batch) > 0 {
-				remediationPlan = append(remediationPlan, batch)
-				clusterCount = 0
-				batch = nil
-			}
+	// The batch sizes were already validated in validateCR
+	batchSizes, _ := utils.GetBatchSizes(clusterGroupUpgrade.Spec.RemediationStrategy.BatchSizes, len(clusters))
+	maxConcurrency := clusterGroupUpgrade.Status.ComputedMaxConcurrency
+	spreadBy := clusterGroupUpgrade.Spec.RemediationStrategy.SpreadBy
+	batchBy := clusterGroupUpgrade.Spec.RemediationStrategy.BatchBy
+	switch {
+	case clusterGroupUpgrade.Spec.RemediationStrategy.Mode == ranv1alpha1.RemediationMode.Rolling:
+		// Rolling clusters start as slots free up, maxConcurrency is enforced when starting them
+		if len(clustersToRemediate) > 0 {
+			remediationPlan = append(remediationPlan, clustersToRemediate)
+		}
+	case spreadBy != nil:
+		domains, err := r.getClustersTopologyDomains(ctx, clustersToRemediate, spreadBy.LabelKey)
+		if err != nil {
+			return nil, err
 		}
+		remediationPlan = append(remediationPlan,
+			utils.SpreadIntoBatches(clustersToRemediate, domains, spreadBy.MaxPerBatch, batchSizes, maxConcurrency)...)
+	case batchBy != "":
+		domains, err := r.getClustersTopologyDomains(ctx, clustersToRemediate, batchBy)
+		if err != nil {
+			return nil, err
+		}
+		remediationPlan = append(remediationPlan,
+			utils.GroupIntoBatches(clustersToRemediate, domains, batchSizes, maxConcurrency)...)
+	default:
+		remediationPlan = append(remediationPlan,
+			utils.SplitIntoBatches(clustersToRemediate, batchSizes, maxConcurrency)...)
 	}
 	r.Log.Info("Remediation plan", "remediatePlan", remediationPlan)
 	clusterGroupUpgrade.Status.RemediationPlan = remediationPlan
-	return compliantClusters
+	return compliantClusters, nil
+}
+
+// getClustersTopologyDomains returns the value of the given label for each of the clusters that have it
+func (r *ClusterGroupUpgradeReconciler) getClustersTopologyDomains(
+	ctx context.Context, clusters []string, labelKey string) (map[string]string, error) {
+	domains := make(map[string]string)
+	for _, cluster := range clusters {
+		managedCluster := &clusterv1.ManagedCluster{}
+		if err := r.Get(ctx, types.NamespacedName{Name: cluster}, managedCluster); err != nil {
+			return nil, fmt.Errorf("failed to get ManagedCluster %s: %w", cluster, err)
+		}
+		if value, ok := managedCluster.GetLabels()[labelKey]; ok {
+			domains[cluster] = value
+		}
+	}
+	return domains, nil
 }
 
 func (r *ClusterGroupUpgradeReconciler) getAllClustersForUpgrade(ctx context.Context, clusterGroupUpgrade *ranv1alpha1.ClusterGroupUpgrade) ([]string, error) {
@@ -1112,6 +1932,26 @@ func (r *ClusterGroupUpgradeReconciler) getAllClustersForUpgrade(ctx context.Con
 	selectorClusters := []string{}
 	keys := make(map[string]bool)
 
+	// A retry CGU remediates the clusters that timed out in the referenced CGU
+	if clusterGroupUpgrade.Spec.RetryOf != nil {
+		// nolint: staticcheck
+		if clusterGroupUpgrade.Spec.Clusters != nil ||
+			clusterGroupUpgrade.Spec.ClusterSelector != nil ||
+			clusterGroupUpgrade.Spec.ClusterLabelSelectors != nil {
+			return clusterNames, errors.NewBadRequest("retryOf cannot be used together with clusters, clusterSelector or clusterLabelSelectors")
+		}
+		retriedCgu, err := r.getRetriedCGU(ctx, clusterGroupUpgrade)
+		if err != nil {
+			return clusterNames, err
+		}
+		clusterNames = utils.GetFailedClusters(retriedCgu)
+		if len(clusterNames) == 0 {
+			return clusterNames, errors.NewBadRequest(fmt.Sprintf(
+				"ClusterGroupUpgrade %s/%s has no timed out clusters to retry", retriedCgu.Namespace, retriedCgu.Name))
+		}
+		return clusterNames, nil
+	}
+
 	// Check to make sure at least one cluster selection method is defined
 	// nolint: staticcheck
 	if clusterGroupUpgrade.Spec.Clusters == nil &&
@@ -1202,6 +2042,73 @@ func (r *ClusterGroupUpgradeReconciler) getAllClustersForUpgrade(ctx context.Con
 	return clusterNames, nil
 }
 
+// getRetriedCGU returns the completed ClusterGroupUpgrade referenced by spec.retryOf
+func (r *ClusterGroupUpgradeReconciler) getRetriedCGU(
+	ctx context.Context, clusterGroupUpgrade *ranv1alpha1.ClusterGroupUpgrade) (*ranv1alpha1.ClusterGroupUpgrade, error) {
+
+	namespace := clusterGroupUpgrade.Spec.RetryOf.Namespace
+	if namespace == "" {
+		namespace = clusterGroupUpgrade.Namespace
+	}
+	retriedCgu := &ranv1alpha1.ClusterGroupUpgrade{}
+	if err := r.Get(ctx, types.NamespacedName{Name: clusterGroupUpgrade.Spec.RetryOf.Name, Namespace: namespace}, retriedCgu); err != nil {
+		return nil, fmt.Errorf("failed to get the ClusterGroupUpgrade %s/%s to retry: %w", namespace, clusterGroupUpgrade.Spec.RetryOf.Name, err)
+	}
+	if retriedCgu.Status.Status.CompletedAt.IsZero() {
+		return nil, errors.NewBadRequest(fmt.Sprintf(
+			"ClusterGroupUpgrade %s/%s to retry is not completed", namespace, retriedCgu.Name))
+	}
+	return retriedCgu, nil
+}
+
+// applyRetryOf records the managed policies and manifestwork templates of the retried CGU in the status when the
+// retry CGU doesn't define its own, and how many times each of its clusters has been retried.
+// It returns true if the CGU has been updated.
+func (r *ClusterGroupUpgradeReconciler) applyRetryOf(
+	ctx context.Context, clusterGroupUpgrade *ranv1alpha1.ClusterGroupUpgrade, clusters []string) (bool, error) {
+
+	retriedCgu, err := r.getRetriedCGU(ctx, clusterGroupUpgrade)
+	if err != nil {
+		return false, err
+	}
+
+	if len(clusterGroupUpgrade.Spec.ManagedPolicies) == 0 && len(clusterGroupUpgrade.Spec.ManifestWorkTemplates) == 0 {
+		// The retried CGU may be a retry itself
+		useInheritedRollout(retriedCgu)
+		clusterGroupUpgrade.Status.InheritedManagedPolicies = retriedCgu.Spec.ManagedPolicies
+		clusterGroupUpgrade.Status.InheritedManifestWorkTemplates = retriedCgu.Spec.ManifestWorkTemplates
+		if err := r.updateStatus(ctx, clusterGroupUpgrade); err != nil {
+			return false, err
+		}
+		return true, nil
+	}
+
+	retryCounts := make(map[string]int, len(clusters))
+	for _, cluster := range clusters {
+		retryCounts[cluster] = retriedCgu.Status.ClusterRetryCounts[cluster] + 1
+	}
+	if reflect.DeepEqual(retryCounts, clusterGroupUpgrade.Status.ClusterRetryCounts) {
+		return false, nil
+	}
+	clusterGroupUpgrade.Status.ClusterRetryCounts = retryCounts
+	if err := r.updateStatus(ctx, clusterGroupUpgrade); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// useInheritedRollout sets the managed policies and manifestwork templates inherited through spec.retryOf in the
+// in-memory spec of the CGU when it doesn't define its own. The CGU must not be updated afterwards, only its status,
+// so that the spec stays as the user wrote it.
+func useInheritedRollout(clusterGroupUpgrade *ranv1alpha1.ClusterGroupUpgrade) {
+	if clusterGroupUpgrade.Spec.RetryOf == nil ||
+		len(clusterGroupUpgrade.Spec.ManagedPolicies) > 0 || len(clusterGroupUpgrade.Spec.ManifestWorkTemplates) > 0 {
+		return
+	}
+	clusterGroupUpgrade.Spec.ManagedPolicies = clusterGroupUpgrade.Status.InheritedManagedPolicies
+	clusterGroupUpgrade.Spec.ManifestWorkTemplates = clusterGroupUpgrade.Status.InheritedManifestWorkTemplates
+}
+
 // filterFailedPrecachingClusters filters the input cluster list by removing any clusters which failed to perform their backup.
 func (r *ClusterGroupUpgradeReconciler) filterFailedPrecachingClusters(clusterGroupUpgrade *ranv1alpha1.ClusterGroupUpgrade, clusters []string) []string {
 	var clustersList []string
@@ -1243,7 +2150,12 @@ func (r *ClusterGroupUpgradeReconciler) updateStatus(ctx context.Context, cluste
 	if err != nil {
 		return err
 	}
+	// The update sets the object back to the stored spec
+	useInheritedRollout(clusterGroupUpgrade)
 
+	// The transitions are persisted, they can be observed
+	recordObservations(ctx)
+	updateCGUMetrics(clusterGroupUpgrade)
 	return nil
 }
 
@@ -1309,10 +2221,13 @@ func (r *ClusterGroupUpgradeReconciler) blockingCRsNotCompleted(ctx context.Cont
 	return blockingCRsNotCompleted, blockingCRsMissing, nil
 }
 
-func (r *ClusterGroupUpgradeReconciler) validateCR(ctx context.Context, clusterGroupUpgrade *ranv1alpha1.ClusterGroupUpgrade) ([]string, []string, bool, error) {
-	reconcile := false
+func (r *ClusterGroupUpgradeReconciler) validateCR(ctx context.Context, clusterGroupUpgrade *ranv1alpha1.ClusterGroupUpgrade) (
+	clusters []string, missingClusters []string, reconcile bool, err error) {
+	ctx, span := utils.StartCGUSpan(ctx, "validateCR", clusterGroupUpgrade)
+	defer func() { utils.EndSpan(span, err) }()
+
 	// Validate clusters in spec are ManagedCluster objects
-	clusters, err := r.getAllClustersForUpgrade(ctx, clusterGroupUpgrade)
+	clusters, err = r.getAllClustersForUpgrade(ctx, clusterGroupUpgrade)
 	if err != nil {
 		return nil, nil, reconcile, fmt.Errorf("cannot obtain all the details about the clusters in the CR: %s", err)
 	}
@@ -1346,15 +2261,68 @@ func (r *ClusterGroupUpgradeReconciler) validateCR(ctx context.Context, clusterG
 		}
 	}
 
-	var newMaxConcurrency int
-	// Automatically adjust maxConcurrency to the min of maxConcurrency and the number of clusters.
-	if clusterGroupUpgrade.Spec.RemediationStrategy.MaxConcurrency > 0 &&
-		clusterGroupUpgrade.Spec.RemediationStrategy.MaxConcurrency < len(clusters) {
-		newMaxConcurrency = clusterGroupUpgrade.Spec.RemediationStrategy.MaxConcurrency
-	} else {
-		newMaxConcurrency = len(clusters)
+	if len(clusterGroupUpgrade.Spec.ManagedPolicies) > 0 && len(clusterGroupUpgrade.Spec.ManifestWorkTemplates) > 0 {
+		return nil, nil, reconcile, fmt.Errorf("managedPolicies and manifestWorkTemplates cannot be used together")
+	}
+
+	if clusterGroupUpgrade.Spec.RemediationStrategy.OnCanaryFailure == ranv1alpha1.OnCanaryFailureAction.Rollback {
+		if clusterGroupUpgrade.RolloutType() != ranv1alpha1.RolloutTypes.Policy {
+			return nil, nil, reconcile, fmt.Errorf("onCanaryFailure Rollback is only supported with managedPolicies")
+		}
+		if len(clusterGroupUpgrade.Spec.RemediationStrategy.RollbackPolicies) == 0 {
+			return nil, nil, reconcile, fmt.Errorf("rollbackPolicies must be set when onCanaryFailure is Rollback")
+		}
+	}
+
+	for _, override := range clusterGroupUpgrade.Spec.PolicyOverrides {
+		if _, found := utils.FindStringInSlice(clusterGroupUpgrade.Spec.ManagedPolicies, override.Name); !found {
+			return nil, nil, reconcile, fmt.Errorf("policyOverrides policy %s is not in the managedPolicies", override.Name)
+		}
+	}
+
+	if _, err := utils.GetMaxFailures(clusterGroupUpgrade.Spec.RemediationStrategy.MaxFailures, len(clusters)); err != nil {
+		return nil, nil, reconcile, err
+	}
+
+	if clusterGroupUpgrade.Spec.RemediationStrategy.Mode == ranv1alpha1.RemediationMode.Rolling {
+		if len(clusterGroupUpgrade.Spec.RemediationStrategy.BatchSizes) > 0 ||
+			clusterGroupUpgrade.Spec.RemediationStrategy.SpreadBy != nil ||
+			clusterGroupUpgrade.Spec.RemediationStrategy.BatchBy != "" {
+			return nil, nil, reconcile, fmt.Errorf("batchSizes, spreadBy and batchBy cannot be used in Rolling mode")
+		}
+	}
+
+	if clusterGroupUpgrade.Spec.RemediationStrategy.SpreadBy != nil {
+		if clusterGroupUpgrade.Spec.RemediationStrategy.BatchBy != "" {
+			return nil, nil, reconcile, fmt.Errorf("spreadBy and batchBy cannot be used together")
+		}
+		if clusterGroupUpgrade.Spec.RemediationStrategy.SpreadBy.LabelKey == "" {
+			return nil, nil, reconcile, fmt.Errorf("spreadBy.labelKey must be set")
+		}
 	}
 
+	if err := utils.ValidateMaintenanceWindows(clusterGroupUpgrade.Spec.MaintenanceWindows); err != nil {
+		return nil, nil, reconcile, err
+	}
+
+	if _, err := utils.GetBatchSizes(clusterGroupUpgrade.Spec.RemediationStrategy.BatchSizes, len(clusters)); err != nil {
+		return nil, nil, reconcile, err
+	}
+
+	if clusterGroupUpgrade.Spec.RetryOf != nil {
+		updated, err := r.applyRetryOf(ctx, clusterGroupUpgrade, clusters)
+		if err != nil {
+			return nil, nil, reconcile, err
+		}
+		if updated {
+			return clusters, nil, true, nil
+		}
+	}
+
+	// Automatically adjust maxConcurrency to the min of maxConcurrency and the number of clusters.
+	newMaxConcurrency := utils.GetMaxConcurrency(clusterGroupUpgrade.Spec.RemediationStrategy.MaxConcurrency,
+		clusterGroupUpgrade.Spec.RemediationStrategy.MaxConcurrencyPercentage, len(clusters))
+
 	if newMaxConcurrency != clusterGroupUpgrade.Status.ComputedMaxConcurrency {
 		clusterGroupUpgrade.Status.ComputedMaxConcurrency = newMaxConcurrency
 		err = r.updateStatus(ctx, clusterGroupUpgrade)
@@ -1392,6 +2360,8 @@ func (r *ClusterGroupUpgradeReconciler) handleCguFinalizer(
 				}
 			}
 
+			// The deleted CGU is not reconciled again, remove its gauges now
+			deleteCGUMetrics(clusterGroupUpgrade.Namespace, clusterGroupUpgrade.Name)
 			// Remove cguFinalizer. Once all finalizers have been removed, the object will be deleted.
 			controllerutil.RemoveFinalizer(clusterGroupUpgrade, utils.CleanupFinalizer)
 			if err := r.Update(ctx, clusterGroupUpgrade); err != nil {
@@ -1441,7 +2411,8 @@ func (r *ClusterGroupUpgradeReconciler) managedClusterResourceMapper(ctx context
 
 // SetupWithManager sets up the controller with the Manager.
 func (r *ClusterGroupUpgradeReconciler) SetupWithManager(mgr ctrl.Manager) error {
-	r.EventEmitter = NewEmitter(mgr.GetClient(), mgr.GetScheme(), "ClusterGroupUpgrade")
+	r.EventEmitter = NewEmitter(mgr.GetClient(), mgr.GetScheme(), "ClusterGroupUpgrade").
+		WithNotifier(r.Notifier)
 
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&ranv1alpha1.ClusterGroupUpgrade{}, builder.WithPredicates(predicate.Funcs{
@@ -18,16 +18,32 @@ package controllers
 
 import (
 	"context"
+	"errors"
 	"os"
 	"testing"
+	"time"
 
 	"github.com/go-logr/logr"
 	"github.com/openshift-kni/cluster-group-upgrades-operator/controllers/utils"
 	"github.com/openshift-kni/cluster-group-upgrades-operator/pkg/api/clustergroupupgrades/v1alpha1"
+	viewv1beta1 "github.com/stolostron/cluster-lifecycle-api/view/v1beta1"
 	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	coordinationv1 "k8s.io/api/coordination/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	clusterv1 "open-cluster-management.io/api/cluster/v1"
+	clusterv1beta1 "open-cluster-management.io/api/cluster/v1beta1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
 )
 
 func TestBlockingCRsNotCompletedWihtPartialComplete(t *testing.T) {
@@ -477,3 +493,593 @@ func TestClusterGroupUpgradeReconciler_getCGUControllerWorkerCount(t *testing.T)
 		})
 	}
 }
+
+func TestClusterGroupUpgradeReconciler_pauseAndResumeUpgrade(t *testing.T) {
+	r := &ClusterGroupUpgradeReconciler{
+		Log: logr.Discard(),
+	}
+	startedAt := time.Now().Add(-time.Hour)
+	batchStartedAt := time.Now().Add(-10 * time.Minute)
+	cgu := &v1alpha1.ClusterGroupUpgrade{
+		ObjectMeta: v1.ObjectMeta{Name: "cgu", Namespace: "namespace"},
+		Status: v1alpha1.ClusterGroupUpgradeStatus{
+			Status: v1alpha1.UpgradeStatus{
+				StartedAt:             v1.NewTime(startedAt),
+				CurrentBatch:          2,
+				CurrentBatchStartedAt: v1.NewTime(batchStartedAt),
+			},
+		},
+	}
+
+	r.pauseUpgrade(context.TODO(), cgu)
+	assert.NotNil(t, cgu.Status.Status.PausedAt)
+	progressing := meta.FindStatusCondition(cgu.Status.Conditions, string(utils.ConditionTypes.Progressing))
+	assert.Equal(t, string(utils.ConditionReasons.Paused), progressing.Reason)
+	assert.Equal(t, v1.ConditionTrue, progressing.Status)
+
+	// Pausing an already paused CGU keeps the original pause time
+	pausedAt := v1.NewTime(time.Now().Add(-30 * time.Minute))
+	cgu.Status.Status.PausedAt = &pausedAt
+	r.pauseUpgrade(context.TODO(), cgu)
+	assert.Equal(t, pausedAt, *cgu.Status.Status.PausedAt)
+
+	r.resumeUpgrade(context.TODO(), cgu)
+	assert.Nil(t, cgu.Status.Status.PausedAt)
+	progressing = meta.FindStatusCondition(cgu.Status.Conditions, string(utils.ConditionTypes.Progressing))
+	assert.Equal(t, string(utils.ConditionReasons.InProgress), progressing.Reason)
+	// The 30 minutes spent paused are not counted against the timeouts
+	assert.WithinDuration(t, startedAt.Add(30*time.Minute), cgu.Status.Status.StartedAt.Time, time.Minute)
+	assert.WithinDuration(t, batchStartedAt.Add(30*time.Minute), cgu.Status.Status.CurrentBatchStartedAt.Time, time.Minute)
+}
+
+func TestClusterGroupUpgradeReconciler_buildRemediationPlanSpreadBy(t *testing.T) {
+	var objs []client.Object
+	for _, cluster := range []struct{ name, site string }{
+		{"spoke1", "site-a"}, {"spoke2", "site-a"}, {"spoke3", "site-b"}, {"spoke4", "site-b"}, {"spoke5", ""},
+	} {
+		managedCluster := &clusterv1.ManagedCluster{ObjectMeta: v1.ObjectMeta{Name: cluster.name}}
+		if cluster.site != "" {
+			managedCluster.Labels = map[string]string{"site": cluster.site}
+		}
+		objs = append(objs, managedCluster)
+	}
+	fakeClient, err := getFakeClientFromObjects(objs...)
+	if err != nil {
+		t.Fatalf("error creating fake client: %v", err)
+	}
+	r := &ClusterGroupUpgradeReconciler{Client: fakeClient, Log: logr.Discard(), Scheme: testscheme}
+	clusters := []string{"spoke1", "spoke2", "spoke3", "spoke4", "spoke5"}
+
+	cgu := &v1alpha1.ClusterGroupUpgrade{
+		ObjectMeta: v1.ObjectMeta{Name: "cgu", Namespace: "namespace"},
+		Spec: v1alpha1.ClusterGroupUpgradeSpec{
+			ManifestWorkTemplates: []string{"template"},
+			RemediationStrategy: &v1alpha1.RemediationStrategySpec{
+				Canaries: []string{"spoke3"},
+				SpreadBy: &v1alpha1.SpreadBySpec{LabelKey: "site", MaxPerBatch: 1},
+			},
+		},
+		Status: v1alpha1.ClusterGroupUpgradeStatus{ComputedMaxConcurrency: 3},
+	}
+	_, err = r.buildRemediationPlan(context.TODO(), cgu, clusters, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, [][]string{{"spoke3"}, {"spoke1", "spoke4", "spoke5"}, {"spoke2"}}, cgu.Status.RemediationPlan)
+
+	cgu.Spec.RemediationStrategy.SpreadBy = nil
+	cgu.Spec.RemediationStrategy.BatchBy = "site"
+	_, err = r.buildRemediationPlan(context.TODO(), cgu, clusters, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, [][]string{{"spoke3"}, {"spoke1", "spoke2", "spoke4"}, {"spoke5"}}, cgu.Status.RemediationPlan)
+}
+
+func TestClusterGroupUpgradeReconciler_tracing(t *testing.T) {
+	defer otel.SetTracerProvider(otel.GetTracerProvider())
+	recorder := tracetest.NewSpanRecorder()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+
+	fakeClient, err := getFakeClientFromObjects()
+	if err != nil {
+		t.Fatalf("error creating fake client: %v", err)
+	}
+	r := &ClusterGroupUpgradeReconciler{Client: fakeClient, Log: logr.Discard(), Scheme: testscheme}
+	cgu := &v1alpha1.ClusterGroupUpgrade{
+		ObjectMeta: v1.ObjectMeta{Name: "cgu", Namespace: "namespace"},
+		Spec: v1alpha1.ClusterGroupUpgradeSpec{
+			ManifestWorkTemplates: []string{"template"},
+			RemediationStrategy:   &v1alpha1.RemediationStrategySpec{},
+		},
+		Status: v1alpha1.ClusterGroupUpgradeStatus{ComputedMaxConcurrency: 1},
+	}
+
+	ctx, span := utils.StartCGUSpan(context.TODO(), "Reconcile", cgu)
+	_, err = r.buildRemediationPlan(ctx, cgu, []string{"spoke1"}, nil)
+	assert.NoError(t, err)
+	_, present, err := r.getView(ctx, "view-precache-job", "spoke1")
+	assert.NoError(t, err)
+	assert.False(t, present)
+	span.End()
+
+	spans := recorder.Ended()
+	assert.Len(t, spans, 3)
+	assert.Equal(t, "buildRemediationPlan", spans[0].Name())
+	assert.Contains(t, spans[0].Attributes(), utils.TraceAttributeCGUName.String("cgu"))
+	assert.Equal(t, "GetManagedClusterView", spans[1].Name())
+	assert.Contains(t, spans[1].Attributes(), utils.TraceAttributeCluster.String("spoke1"))
+	assert.Contains(t, spans[1].Attributes(), utils.TraceAttributeResourceName.String("view-precache-job"))
+	for _, child := range spans[:2] {
+		assert.Equal(t, spans[2].SpanContext().SpanID(), child.Parent().SpanID())
+	}
+
+	// The spans of the failed calls record the error
+	r.Client = interceptor.NewClient(fakeClient, interceptor.Funcs{
+		Get: func(context.Context, client.WithWatch, client.ObjectKey, client.Object, ...client.GetOption) error {
+			return errors.New("get failed")
+		},
+	})
+	_, _, err = r.getView(context.TODO(), "view-precache-job", "spoke1")
+	assert.Error(t, err)
+	spans = recorder.Ended()
+	assert.Len(t, spans, 4)
+	assert.Equal(t, codes.Error, spans[3].Status().Code)
+	assert.Equal(t, "get failed", spans[3].Status().Description)
+}
+
+func TestClusterGroupUpgradeReconciler_waitForMaintenanceWindow(t *testing.T) {
+	r := &ClusterGroupUpgradeReconciler{
+		Log: logr.Discard(),
+	}
+	now := time.Now().UTC()
+	startedAt := now.Add(-time.Hour)
+	cgu := &v1alpha1.ClusterGroupUpgrade{
+		ObjectMeta: v1.ObjectMeta{Name: "cgu", Namespace: "namespace"},
+		Spec: v1alpha1.ClusterGroupUpgradeSpec{
+			MaintenanceWindows: []v1alpha1.MaintenanceWindow{
+				{StartTime: now.Add(2 * time.Hour).Format("15:04"), Duration: v1.Duration{Duration: time.Hour}},
+			},
+		},
+		Status: v1alpha1.ClusterGroupUpgradeStatus{
+			Status: v1alpha1.UpgradeStatus{
+				StartedAt:    v1.NewTime(startedAt),
+				CurrentBatch: 2,
+			},
+		},
+	}
+
+	waitFor, waiting := r.waitForMaintenanceWindow(cgu)
+	assert.True(t, waiting)
+	assert.InDelta(t, (2 * time.Hour).Seconds(), waitFor.Seconds(), 60)
+	assert.NotNil(t, cgu.Status.Status.WaitingForMaintenanceWindowSince)
+	progressing := meta.FindStatusCondition(cgu.Status.Conditions, string(utils.ConditionTypes.Progressing))
+	assert.Equal(t, string(utils.ConditionReasons.WaitingForMaintenanceWindow), progressing.Reason)
+
+	// Once the window opens, the time spent waiting is not counted against the timeout
+	waitingSince := v1.NewTime(now.Add(-20 * time.Minute))
+	cgu.Status.Status.WaitingForMaintenanceWindowSince = &waitingSince
+	cgu.Spec.MaintenanceWindows[0].StartTime = now.Add(-time.Hour).Format("15:04")
+	cgu.Spec.MaintenanceWindows[0].Duration = v1.Duration{Duration: 2 * time.Hour}
+	_, waiting = r.waitForMaintenanceWindow(cgu)
+	assert.False(t, waiting)
+	assert.Nil(t, cgu.Status.Status.WaitingForMaintenanceWindowSince)
+	assert.WithinDuration(t, startedAt.Add(20*time.Minute), cgu.Status.Status.StartedAt.Time, time.Minute)
+	progressing = meta.FindStatusCondition(cgu.Status.Conditions, string(utils.ConditionTypes.Progressing))
+	assert.Equal(t, string(utils.ConditionReasons.InProgress), progressing.Reason)
+}
+
+func TestScheduledStartDelay(t *testing.T) {
+	cgu := &v1alpha1.ClusterGroupUpgrade{}
+	assert.Equal(t, time.Duration(0), scheduledStartDelay(cgu))
+
+	startAt := v1.NewTime(time.Now().Add(-time.Minute))
+	cgu.Spec.StartAt = &startAt
+	assert.LessOrEqual(t, scheduledStartDelay(cgu), time.Duration(0))
+
+	startAt = v1.NewTime(time.Now().Add(time.Hour))
+	cgu.Spec.StartAt = &startAt
+	assert.InDelta(t, time.Hour.Seconds(), scheduledStartDelay(cgu).Seconds(), 5)
+}
+
+func TestClusterGroupUpgradeReconciler_validateCRRetryOf(t *testing.T) {
+	retriedCgu := &v1alpha1.ClusterGroupUpgrade{
+		ObjectMeta: v1.ObjectMeta{Name: "cgu", Namespace: "namespace"},
+		Spec: v1alpha1.ClusterGroupUpgradeSpec{
+			Clusters:            []string{"spoke1", "spoke2", "spoke3"},
+			ManagedPolicies:     []string{"policy1", "policy2"},
+			RemediationStrategy: &v1alpha1.RemediationStrategySpec{},
+		},
+		Status: v1alpha1.ClusterGroupUpgradeStatus{
+			Clusters: []v1alpha1.ClusterState{
+				{Name: "spoke1", State: utils.ClusterRemediationComplete},
+				{Name: "spoke2", State: utils.ClusterRemediationTimedout},
+				{Name: "spoke3", State: utils.ClusterRemediationTimedout},
+			},
+			ClusterRetryCounts: map[string]int{"spoke2": 1},
+		},
+	}
+	cgu := &v1alpha1.ClusterGroupUpgrade{
+		ObjectMeta: v1.ObjectMeta{Name: "cgu-retry", Namespace: "namespace"},
+		Spec: v1alpha1.ClusterGroupUpgradeSpec{
+			RetryOf:             &v1alpha1.NamespacedCR{Name: "cgu"},
+			RemediationStrategy: &v1alpha1.RemediationStrategySpec{MaxConcurrency: 1},
+		},
+		Status: v1alpha1.ClusterGroupUpgradeStatus{ComputedMaxConcurrency: 1},
+	}
+	objs := []client.Object{retriedCgu, cgu}
+	for _, name := range []string{"spoke1", "spoke2", "spoke3"} {
+		objs = append(objs, &clusterv1.ManagedCluster{ObjectMeta: v1.ObjectMeta{Name: name}})
+	}
+	fakeClient := fake.NewClientBuilder().WithScheme(testscheme).WithObjects(objs...).
+		WithStatusSubresource(&v1alpha1.ClusterGroupUpgrade{}).Build()
+	r := &ClusterGroupUpgradeReconciler{Client: fakeClient, Log: logr.Discard(), Scheme: testscheme}
+
+	// The retried CGU is not completed yet
+	_, _, _, err := r.validateCR(context.TODO(), cgu)
+	assert.ErrorContains(t, err, "is not completed")
+
+	retriedCgu.Status.Status.CompletedAt = v1.Now()
+	assert.NoError(t, fakeClient.Status().Update(context.TODO(), retriedCgu))
+
+	// The managed policies are copied from the retried CGU
+	clusters, _, reconcile, err := r.validateCR(context.TODO(), cgu)
+	assert.NoError(t, err)
+	assert.True(t, reconcile)
+	assert.Equal(t, []string{"spoke2", "spoke3"}, clusters)
+	assert.Equal(t, []string{"policy1", "policy2"}, cgu.Spec.ManagedPolicies)
+	// Only the status records them, the spec is left as written
+	storedCgu := &v1alpha1.ClusterGroupUpgrade{}
+	assert.NoError(t, fakeClient.Get(context.TODO(), client.ObjectKeyFromObject(cgu), storedCgu))
+	assert.Empty(t, storedCgu.Spec.ManagedPolicies)
+	assert.Equal(t, []string{"policy1", "policy2"}, storedCgu.Status.InheritedManagedPolicies)
+	storedCgu.Spec.ManagedPolicies = nil
+	useInheritedRollout(storedCgu)
+	assert.Equal(t, []string{"policy1", "policy2"}, storedCgu.Spec.ManagedPolicies)
+
+	// The retry counts are recorded
+	_, _, reconcile, err = r.validateCR(context.TODO(), cgu)
+	assert.NoError(t, err)
+	assert.True(t, reconcile)
+	assert.Equal(t, map[string]int{"spoke2": 2, "spoke3": 1}, cgu.Status.ClusterRetryCounts)
+
+	_, _, reconcile, err = r.validateCR(context.TODO(), cgu)
+	assert.NoError(t, err)
+	assert.False(t, reconcile)
+
+	cgu.Spec.Clusters = []string{"spoke1"}
+	_, _, _, err = r.validateCR(context.TODO(), cgu)
+	assert.ErrorContains(t, err, "retryOf cannot be used together with clusters")
+}
+
+func TestClusterGroupUpgradeReconciler_updateDynamicMembership(t *testing.T) {
+	var objs []client.Object
+	for _, name := range []string{"spoke1", "spoke2", "spoke4", "spoke5", "spoke6"} {
+		objs = append(objs, &clusterv1.ManagedCluster{
+			ObjectMeta: v1.ObjectMeta{Name: name, Labels: map[string]string{"upgrade": "true"}},
+		})
+	}
+	fakeClient, err := getFakeClientFromObjects(objs...)
+	if err != nil {
+		t.Fatalf("error creating fake client: %v", err)
+	}
+	r := &ClusterGroupUpgradeReconciler{Client: fakeClient, Log: logr.Discard(), Scheme: testscheme}
+
+	cgu := &v1alpha1.ClusterGroupUpgrade{
+		ObjectMeta: v1.ObjectMeta{Name: "cgu", Namespace: "namespace"},
+		Spec: v1alpha1.ClusterGroupUpgradeSpec{
+			DynamicMembership:     true,
+			ManifestWorkTemplates: []string{"template"},
+			ClusterLabelSelectors: []v1.LabelSelector{{MatchLabels: map[string]string{"upgrade": "true"}}},
+			RemediationStrategy:   &v1alpha1.RemediationStrategySpec{},
+		},
+		Status: v1alpha1.ClusterGroupUpgradeStatus{
+			ComputedMaxConcurrency: 2,
+			// spoke3 was deleted, spoke5 and spoke6 are new
+			RemediationPlan: [][]string{{"spoke1"}, {"spoke2", "spoke3"}, {"spoke4"}},
+			Status: v1alpha1.UpgradeStatus{
+				CurrentBatch: 2,
+				CurrentBatchRemediationProgress: map[string]*v1alpha1.ClusterRemediationProgress{
+					"spoke2": {State: v1alpha1.InProgress},
+					"spoke3": {State: v1alpha1.InProgress},
+				},
+			},
+		},
+	}
+	err = r.updateDynamicMembership(context.TODO(), cgu)
+	assert.NoError(t, err)
+	assert.Equal(t, [][]string{{"spoke1"}, {"spoke2"}, {"spoke4", "spoke5"}, {"spoke6"}}, cgu.Status.RemediationPlan)
+	assert.NotContains(t, cgu.Status.Status.CurrentBatchRemediationProgress, "spoke3")
+	assert.Equal(t, []v1alpha1.ClusterState{{Name: "spoke3", State: utils.ClusterRemediationRemoved}}, cgu.Status.Clusters)
+
+	// Nothing changes once the plan is up to date
+	err = r.updateDynamicMembership(context.TODO(), cgu)
+	assert.NoError(t, err)
+	assert.Equal(t, [][]string{{"spoke1"}, {"spoke2"}, {"spoke4", "spoke5"}, {"spoke6"}}, cgu.Status.RemediationPlan)
+	assert.Len(t, cgu.Status.Clusters, 1)
+}
+
+func TestClusterGroupUpgradeReconciler_handleUnavailableClusters(t *testing.T) {
+	available := []v1.Condition{{Type: clusterv1.ManagedClusterConditionAvailable, Status: v1.ConditionTrue}}
+	unavailable := []v1.Condition{{Type: clusterv1.ManagedClusterConditionAvailable, Status: v1.ConditionUnknown}}
+	freshLease := v1.NewMicroTime(time.Now())
+	staleLease := v1.NewMicroTime(time.Now().Add(-time.Hour))
+	objs := []client.Object{
+		&clusterv1.ManagedCluster{ObjectMeta: v1.ObjectMeta{Name: "spoke1"}, Status: clusterv1.ManagedClusterStatus{Conditions: available}},
+		&clusterv1.ManagedCluster{ObjectMeta: v1.ObjectMeta{Name: "spoke2"}, Status: clusterv1.ManagedClusterStatus{Conditions: unavailable}},
+		&clusterv1.ManagedCluster{ObjectMeta: v1.ObjectMeta{Name: "spoke3"}, Status: clusterv1.ManagedClusterStatus{Conditions: available}},
+		&clusterv1.ManagedCluster{ObjectMeta: v1.ObjectMeta{Name: "spoke4"}, Status: clusterv1.ManagedClusterStatus{Conditions: available}},
+		&coordinationv1.Lease{
+			ObjectMeta: v1.ObjectMeta{Name: utils.ManagedClusterLeaseName, Namespace: "spoke1"},
+			Spec:       coordinationv1.LeaseSpec{RenewTime: &freshLease},
+		},
+		&coordinationv1.Lease{
+			ObjectMeta: v1.ObjectMeta{Name: utils.ManagedClusterLeaseName, Namespace: "spoke3"},
+			Spec:       coordinationv1.LeaseSpec{RenewTime: &staleLease},
+		},
+		&coordinationv1.Lease{
+			ObjectMeta: v1.ObjectMeta{Name: utils.ManagedClusterLeaseName, Namespace: "spoke4"},
+			Spec:       coordinationv1.LeaseSpec{RenewTime: &freshLease},
+		},
+	}
+	fakeClient, err := getFakeClientFromObjects(objs...)
+	if err != nil {
+		t.Fatalf("error creating fake client: %v", err)
+	}
+	r := &ClusterGroupUpgradeReconciler{Client: fakeClient, Log: logr.Discard(), Scheme: testscheme}
+
+	testcases := []struct {
+		name             string
+		action           string
+		plan             [][]string
+		currentBatch     int
+		expectedPlan     [][]string
+		expectedClusters []v1alpha1.ClusterState
+	}{
+		{
+			name:         "Skip",
+			action:       v1alpha1.UnavailableClustersAction.Skip,
+			plan:         [][]string{{"spoke1", "spoke2", "spoke3"}, {"spoke4"}},
+			currentBatch: 1,
+			expectedPlan: [][]string{{"spoke1"}, {"spoke4"}},
+			expectedClusters: []v1alpha1.ClusterState{
+				{Name: "spoke2", State: utils.ClusterRemediationUnavailable},
+				{Name: "spoke3", State: utils.ClusterRemediationUnavailable},
+			},
+		},
+		{
+			name:         "Defer",
+			action:       v1alpha1.UnavailableClustersAction.Defer,
+			plan:         [][]string{{"spoke1", "spoke2", "spoke3"}, {"spoke4"}},
+			currentBatch: 1,
+			expectedPlan: [][]string{{"spoke1"}, {"spoke4", "spoke2", "spoke3"}},
+		},
+		{
+			name:         "Defer from the last batch",
+			action:       v1alpha1.UnavailableClustersAction.Defer,
+			plan:         [][]string{{"spoke1"}, {"spoke4", "spoke2"}},
+			currentBatch: 2,
+			expectedPlan: [][]string{{"spoke1"}, {"spoke4"}},
+			expectedClusters: []v1alpha1.ClusterState{
+				{Name: "spoke2", State: utils.ClusterRemediationUnavailable},
+			},
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			cgu := &v1alpha1.ClusterGroupUpgrade{
+				ObjectMeta: v1.ObjectMeta{Name: "cgu", Namespace: "namespace"},
+				Spec: v1alpha1.ClusterGroupUpgradeSpec{
+					RemediationStrategy: &v1alpha1.RemediationStrategySpec{
+						UnavailableClusters: &v1alpha1.UnavailableClustersSpec{
+							Action:      tc.action,
+							MaxLeaseAge: &v1.Duration{Duration: 5 * time.Minute},
+						},
+					},
+				},
+				Status: v1alpha1.ClusterGroupUpgradeStatus{
+					ComputedMaxConcurrency: 3,
+					RemediationPlan:        tc.plan,
+					Status:                 v1alpha1.UpgradeStatus{CurrentBatch: tc.currentBatch},
+				},
+			}
+			err := r.handleUnavailableClusters(context.TODO(), cgu)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedPlan, cgu.Status.RemediationPlan)
+			assert.Equal(t, tc.expectedClusters, cgu.Status.Clusters)
+		})
+	}
+}
+
+func TestClusterGroupUpgradeReconciler_runPreflightChecks(t *testing.T) {
+	cgu := &v1alpha1.ClusterGroupUpgrade{
+		ObjectMeta: v1.ObjectMeta{Name: "cgu", Namespace: "namespace"},
+		Spec: v1alpha1.ClusterGroupUpgradeSpec{
+			PreflightChecks: &v1alpha1.HealthChecksSpec{Etcd: true},
+		},
+		Status: v1alpha1.ClusterGroupUpgradeStatus{
+			RemediationPlan: [][]string{{"spoke1", "spoke2"}, {"spoke3"}},
+			Status: v1alpha1.UpgradeStatus{
+				CurrentBatch: 1,
+				CurrentBatchRemediationProgress: map[string]*v1alpha1.ClusterRemediationProgress{
+					"spoke1": {State: v1alpha1.NotStarted},
+					"spoke2": {State: v1alpha1.NotStarted},
+				},
+			},
+		},
+	}
+
+	mcvName := utils.GetMultiCloudObjectName(cgu, utils.PreflightCheckKind, "etcd")
+	etcdView := func(cluster, degraded string) client.Object {
+		return &viewv1beta1.ManagedClusterView{
+			ObjectMeta: v1.ObjectMeta{
+				Name:      utils.GetSafeResourceName(mcvName, "", cgu, utils.MaxObjectNameLength),
+				Namespace: cluster,
+			},
+			Status: viewv1beta1.ViewStatus{
+				Conditions: []v1.Condition{{
+					Type:   viewv1beta1.ConditionViewProcessing,
+					Status: v1.ConditionTrue,
+					Reason: viewv1beta1.ReasonGetResource,
+				}},
+				Result: runtime.RawExtension{Raw: []byte(`{"apiVersion":"config.openshift.io/v1","kind":"ClusterOperator",` +
+					`"metadata":{"name":"etcd"},"status":{"conditions":[{"type":"Available","status":"True"},` +
+					`{"type":"Degraded","status":"` + degraded + `"}]}}`)},
+			},
+		}
+	}
+	fakeClient, err := getFakeClientFromObjects(etcdView("spoke1", "True"), etcdView("spoke2", "False"))
+	if err != nil {
+		t.Fatalf("error creating fake client: %v", err)
+	}
+	r := &ClusterGroupUpgradeReconciler{Client: fakeClient, Log: logr.Discard(), Scheme: testscheme}
+
+	passed, err := r.runPreflightChecks(context.TODO(), cgu, "spoke1")
+	assert.NoError(t, err)
+	assert.False(t, passed)
+	passed, err = r.runPreflightChecks(context.TODO(), cgu, "spoke2")
+	assert.NoError(t, err)
+	assert.True(t, passed)
+
+	assert.Equal(t, [][]string{{"spoke2"}, {"spoke3"}}, cgu.Status.RemediationPlan)
+	assert.NotContains(t, cgu.Status.Status.CurrentBatchRemediationProgress, "spoke1")
+	assert.Equal(t, []v1alpha1.ClusterState{{
+		Name: "spoke1", State: utils.ClusterRemediationPreflightFailed,
+		Reason: "ClusterOperator etcd condition Degraded is True",
+	}}, cgu.Status.Clusters)
+}
+
+func TestClusterGroupUpgradeReconciler_handleClusterTimeout(t *testing.T) {
+	startedAt := v1.NewTime(time.Now().Add(-30 * time.Minute))
+	cgu := &v1alpha1.ClusterGroupUpgrade{
+		ObjectMeta: v1.ObjectMeta{Name: "cgu", Namespace: "namespace"},
+		Spec: v1alpha1.ClusterGroupUpgradeSpec{
+			RemediationStrategy: &v1alpha1.RemediationStrategySpec{
+				ClusterTimeout: 20,
+				MaxFailures:    &intstr.IntOrString{Type: intstr.Int, IntVal: 0},
+			},
+		},
+		Status: v1alpha1.ClusterGroupUpgradeStatus{
+			ManagedPoliciesForUpgrade: []v1alpha1.ManagedPolicyForUpgrade{{Name: "policy1", Namespace: "default"}},
+			RemediationPlan:           [][]string{{"spoke1", "spoke2"}, {"spoke3"}},
+			Status: v1alpha1.UpgradeStatus{
+				CurrentBatch: 1,
+				CurrentBatchRemediationProgress: map[string]*v1alpha1.ClusterRemediationProgress{
+					"spoke1": {State: v1alpha1.InProgress, PolicyIndex: new(int), StartedAt: &startedAt},
+					"spoke2": {State: v1alpha1.InProgress, PolicyIndex: new(int)},
+				},
+			},
+		},
+	}
+	reason := getClusterTimeoutReason(cgu, "spoke1")
+	assert.Equal(t, "Remediation did not complete within the cluster timeout of 20 minutes", reason)
+	assert.Empty(t, getClusterTimeoutReason(cgu, "spoke2"))
+
+	fakeClient, err := getFakeClientFromObjects()
+	if err != nil {
+		t.Fatalf("error creating fake client: %v", err)
+	}
+	r := &ClusterGroupUpgradeReconciler{Client: fakeClient, Log: logr.Discard(), Scheme: testscheme}
+	placement := r.newBatchPlacement(cgu, "policy1", "default", "cgu-policy1-placement", "cgu-policy1-placement")
+	assert.NoError(t, utils.SetPlacementClusterNames(placement, []string{"spoke1", "spoke2"}))
+	assert.NoError(t, fakeClient.Create(context.TODO(), placement))
+
+	assert.NoError(t, r.handleClusterTimeout(context.TODO(), cgu, "spoke1", reason))
+	assert.Equal(t, [][]string{{"spoke2"}, {"spoke3"}}, cgu.Status.RemediationPlan)
+	assert.NotContains(t, cgu.Status.Status.CurrentBatchRemediationProgress, "spoke1")
+	assert.Equal(t, []v1alpha1.ClusterState{{
+		Name: "spoke1", State: utils.ClusterRemediationTimedout,
+		Reason:        "Remediation did not complete within the cluster timeout of 20 minutes",
+		CurrentPolicy: &v1alpha1.PolicyStatus{Name: "policy1", Status: utils.ClusterStatusNonCompliant},
+	}}, cgu.Status.Clusters)
+
+	updatedPlacement := &clusterv1beta1.Placement{}
+	assert.NoError(t, fakeClient.Get(context.TODO(), types.NamespacedName{Name: placement.GetName(), Namespace: "default"}, updatedPlacement))
+	placementClusters, err := utils.GetPlacementClusterNames(updatedPlacement)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"spoke2"}, placementClusters)
+
+	// The timed out cluster is still counted in the total, and 0 failures are allowed
+	isStopped, err := r.handleClusterFailures(context.TODO(), cgu)
+	assert.NoError(t, err)
+	assert.True(t, isStopped)
+	assert.Equal(t, string(utils.ConditionReasons.FailureThresholdExceeded),
+		meta.FindStatusCondition(cgu.Status.Conditions, string(utils.ConditionTypes.Succeeded)).Reason)
+}
+
+func TestClusterGroupUpgradeReconciler_rollingMode(t *testing.T) {
+	var objs []client.Object
+	clusters := []string{"spoke1", "spoke2", "spoke3", "spoke4", "spoke5"}
+	for _, cluster := range clusters {
+		objs = append(objs, &clusterv1.ManagedCluster{ObjectMeta: v1.ObjectMeta{Name: cluster}})
+	}
+	fakeClient, err := getFakeClientFromObjects(objs...)
+	if err != nil {
+		t.Fatalf("error creating fake client: %v", err)
+	}
+	r := &ClusterGroupUpgradeReconciler{Client: fakeClient, Log: logr.Discard(), Scheme: testscheme}
+
+	cgu := &v1alpha1.ClusterGroupUpgrade{
+		ObjectMeta: v1.ObjectMeta{Name: "cgu", Namespace: "namespace"},
+		Spec: v1alpha1.ClusterGroupUpgradeSpec{
+			ManifestWorkTemplates: []string{"template"},
+			RemediationStrategy: &v1alpha1.RemediationStrategySpec{
+				Canaries: []string{"spoke3"},
+				Mode:     v1alpha1.RemediationMode.Rolling,
+			},
+		},
+		Status: v1alpha1.ClusterGroupUpgradeStatus{ComputedMaxConcurrency: 2},
+	}
+	_, err = r.buildRemediationPlan(context.TODO(), cgu, clusters, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, [][]string{{"spoke3"}, {"spoke1", "spoke2", "spoke4", "spoke5"}}, cgu.Status.RemediationPlan)
+
+	cgu.Status.Status.CurrentBatch = 2
+	cgu.Status.Status.CurrentBatchRemediationProgress = map[string]*v1alpha1.ClusterRemediationProgress{
+		"spoke1": {State: v1alpha1.InProgress},
+		"spoke2": {State: v1alpha1.InProgress},
+		"spoke4": {State: v1alpha1.NotStarted},
+		"spoke5": {State: v1alpha1.NotStarted},
+	}
+	cgu.Status.Clusters = []v1alpha1.ClusterState{{Name: "spoke3", State: utils.ClusterRemediationComplete}}
+
+	// All the slots are taken
+	canStart, err := r.canStartClusterRemediation(context.TODO(), cgu, "spoke4", &clusterStartState{})
+	assert.NoError(t, err)
+	assert.False(t, canStart)
+	updateRollingStatus(cgu)
+	assert.Equal(t, &v1alpha1.RollingStatus{
+		InFlightClusters: []string{"spoke1", "spoke2"}, QueuedClusters: 2, CompletedClusters: 1}, cgu.Status.Rolling)
+
+	// A cluster completing frees a slot
+	cgu.Status.Status.CurrentBatchRemediationProgress["spoke1"].State = v1alpha1.Completed
+	cgu.Status.Clusters = append(cgu.Status.Clusters, v1alpha1.ClusterState{Name: "spoke1", State: utils.ClusterRemediationComplete})
+	canStart, err = r.canStartClusterRemediation(context.TODO(), cgu, "spoke4", &clusterStartState{})
+	assert.NoError(t, err)
+	assert.True(t, canStart)
+	updateRollingStatus(cgu)
+	assert.Equal(t, &v1alpha1.RollingStatus{
+		InFlightClusters: []string{"spoke2"}, QueuedClusters: 2, CompletedClusters: 2}, cgu.Status.Rolling)
+}
+
+func TestGetClusterTimeoutReasonPolicyOverride(t *testing.T) {
+	policyTimeout := 10
+	policyStartedAt := v1.NewTime(time.Now().Add(-15 * time.Minute))
+	cgu := &v1alpha1.ClusterGroupUpgrade{
+		Spec: v1alpha1.ClusterGroupUpgradeSpec{
+			ManagedPolicies:     []string{"policy1", "policy2"},
+			PolicyOverrides:     []v1alpha1.PolicyOverride{{Name: "policy2", Timeout: &policyTimeout}},
+			RemediationStrategy: &v1alpha1.RemediationStrategySpec{ClusterTimeout: 60},
+		},
+		Status: v1alpha1.ClusterGroupUpgradeStatus{
+			ManagedPoliciesForUpgrade: []v1alpha1.ManagedPolicyForUpgrade{
+				{Name: "policy1", Namespace: "default"}, {Name: "policy2", Namespace: "default"}},
+			Status: v1alpha1.UpgradeStatus{
+				CurrentBatchRemediationProgress: map[string]*v1alpha1.ClusterRemediationProgress{
+					"spoke1": {State: v1alpha1.InProgress, PolicyIndex: new(int), StartedAt: &policyStartedAt, PolicyStartedAt: &policyStartedAt},
+				},
+			},
+		},
+	}
+	// policy1 has no timeout of its own
+	assert.Empty(t, getClusterTimeoutReason(cgu, "spoke1"))
+
+	*cgu.Status.Status.CurrentBatchRemediationProgress["spoke1"].PolicyIndex = 1
+	assert.Equal(t, "Policy policy2 was not completed within its timeout of 10 minutes", getClusterTimeoutReason(cgu, "spoke1"))
+}
@@ -0,0 +1,140 @@
+package controllers
+
+import (
+	"context"
+	"fmt"
+
+	utils "github.com/openshift-kni/cluster-group-upgrades-operator/controllers/utils"
+	ranv1alpha1 "github.com/openshift-kni/cluster-group-upgrades-operator/pkg/api/clustergroupupgrades/v1alpha1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	clusterv1 "open-cluster-management.io/api/cluster/v1"
+)
+
+// concurrencyState is what the UpgradeConcurrencyPolicies are checked against. It is read once for all the clusters
+// of the batch waiting to start.
+type concurrencyState struct {
+	policies []ranv1alpha1.UpgradeConcurrencyPolicy
+	// Clusters whose remediation is in progress in the other ClusterGroupUpgrades
+	otherInFlightClusters map[string]bool
+	// Labels of all the ManagedClusters by name, only listed when a policy has groups
+	clusterLabels map[string]map[string]string
+}
+
+// getConcurrencyState lists the UpgradeConcurrencyPolicies and, if there are any, what they are checked against
+func (r *ClusterGroupUpgradeReconciler) getConcurrencyState(
+	ctx context.Context, clusterGroupUpgrade *ranv1alpha1.ClusterGroupUpgrade) (*concurrencyState, error) {
+	policies := &ranv1alpha1.UpgradeConcurrencyPolicyList{}
+	if err := r.List(ctx, policies); err != nil {
+		return nil, err
+	}
+	state := &concurrencyState{policies: policies.Items}
+	if len(state.policies) == 0 {
+		return state, nil
+	}
+
+	var err error
+	state.otherInFlightClusters, err = r.getOtherInFlightClusters(ctx, clusterGroupUpgrade)
+	if err != nil {
+		return nil, err
+	}
+	for _, policy := range state.policies {
+		if len(policy.Spec.Groups) > 0 {
+			state.clusterLabels, err = r.getManagedClusterLabels(ctx)
+			if err != nil {
+				return nil, err
+			}
+			break
+		}
+	}
+	return state, nil
+}
+
+// getConcurrencySlotMessage checks the UpgradeConcurrencyPolicies against the clusters being remediated by all the
+// ClusterGroupUpgrades, and returns why the remediation of the cluster has to wait, or an empty string if it can start.
+// The in-memory state of the reconciled ClusterGroupUpgrade is used rather than the stored one, so that the clusters
+// started earlier in the same reconciliation are counted.
+func (r *ClusterGroupUpgradeReconciler) getConcurrencySlotMessage(
+	clusterGroupUpgrade *ranv1alpha1.ClusterGroupUpgrade, clusterName string, state *concurrencyState) string {
+	if len(state.policies) == 0 {
+		return ""
+	}
+
+	inFlightClusters := make(map[string]bool, len(state.otherInFlightClusters))
+	for inFlightCluster := range state.otherInFlightClusters {
+		inFlightClusters[inFlightCluster] = true
+	}
+	for _, inFlightCluster := range getInFlightClusters(clusterGroupUpgrade) {
+		inFlightClusters[inFlightCluster] = true
+	}
+	if inFlightClusters[clusterName] {
+		// Already counted, e.g. by another ClusterGroupUpgrade
+		return ""
+	}
+
+	for _, policy := range state.policies {
+		if policy.Spec.MaxConcurrentClusters > 0 && len(inFlightClusters) >= policy.Spec.MaxConcurrentClusters {
+			return fmt.Sprintf("Waiting for a concurrency slot: %d clusters are being remediated, the limit of UpgradeConcurrencyPolicy %s is %d",
+				len(inFlightClusters), policy.Name, policy.Spec.MaxConcurrentClusters)
+		}
+		for _, group := range policy.Spec.Groups {
+			selector, err := metav1.LabelSelectorAsSelector(&group.Selector)
+			if err != nil {
+				r.Log.Error(err, "Ignoring the concurrency group with an invalid selector", "policy", policy.Name, "group", group.Name)
+				continue
+			}
+			if !selector.Matches(labels.Set(state.clusterLabels[clusterName])) {
+				continue
+			}
+			groupInFlight := 0
+			for inFlightCluster := range inFlightClusters {
+				if selector.Matches(labels.Set(state.clusterLabels[inFlightCluster])) {
+					groupInFlight++
+				}
+			}
+			if groupInFlight >= group.MaxConcurrentClusters {
+				return fmt.Sprintf("Waiting for a concurrency slot: %d clusters of group %s are being remediated, the limit of UpgradeConcurrencyPolicy %s is %d",
+					groupInFlight, group.Name, policy.Name, group.MaxConcurrentClusters)
+			}
+		}
+	}
+	return ""
+}
+
+// getOtherInFlightClusters returns the clusters whose remediation is in progress in the other ClusterGroupUpgrades
+func (r *ClusterGroupUpgradeReconciler) getOtherInFlightClusters(
+	ctx context.Context, clusterGroupUpgrade *ranv1alpha1.ClusterGroupUpgrade) (map[string]bool, error) {
+	cgus := &ranv1alpha1.ClusterGroupUpgradeList{}
+	if err := r.List(ctx, cgus); err != nil {
+		return nil, err
+	}
+	inFlightClusters := make(map[string]bool)
+	for i := range cgus.Items {
+		cgu := &cgus.Items[i]
+		if cgu.Namespace == clusterGroupUpgrade.Namespace && cgu.Name == clusterGroupUpgrade.Name {
+			continue
+		}
+		// The progress of stopped ClusterGroupUpgrades is stale
+		if !meta.IsStatusConditionTrue(cgu.Status.Conditions, string(utils.ConditionTypes.Progressing)) {
+			continue
+		}
+		for _, clusterName := range getInFlightClusters(cgu) {
+			inFlightClusters[clusterName] = true
+		}
+	}
+	return inFlightClusters, nil
+}
+
+// getManagedClusterLabels returns the labels of all the ManagedClusters by name
+func (r *ClusterGroupUpgradeReconciler) getManagedClusterLabels(ctx context.Context) (map[string]map[string]string, error) {
+	managedClusters := &clusterv1.ManagedClusterList{}
+	if err := r.List(ctx, managedClusters); err != nil {
+		return nil, err
+	}
+	clusterLabels := make(map[string]map[string]string, len(managedClusters.Items))
+	for _, managedCluster := range managedClusters.Items {
+		clusterLabels[managedCluster.Name] = managedCluster.GetLabels()
+	}
+	return clusterLabels, nil
+}
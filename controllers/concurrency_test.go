@@ -0,0 +1,118 @@
+package controllers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/openshift-kni/cluster-group-upgrades-operator/controllers/utils"
+	"github.com/openshift-kni/cluster-group-upgrades-operator/pkg/api/clustergroupupgrades/v1alpha1"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/api/meta"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clusterv1 "open-cluster-management.io/api/cluster/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func TestClusterGroupUpgradeReconciler_concurrencyPolicy(t *testing.T) {
+	objs := []client.Object{
+		&clusterv1.ManagedCluster{ObjectMeta: v1.ObjectMeta{Name: "spoke1", Labels: map[string]string{"region": "east"}}},
+		&clusterv1.ManagedCluster{ObjectMeta: v1.ObjectMeta{Name: "spoke2", Labels: map[string]string{"region": "east"}}},
+		&clusterv1.ManagedCluster{ObjectMeta: v1.ObjectMeta{Name: "spoke3", Labels: map[string]string{"region": "east"}}},
+		&clusterv1.ManagedCluster{ObjectMeta: v1.ObjectMeta{Name: "spoke4", Labels: map[string]string{"region": "west"}}},
+		&clusterv1.ManagedCluster{ObjectMeta: v1.ObjectMeta{Name: "spoke5", Labels: map[string]string{"region": "west"}}},
+		// Another ClusterGroupUpgrade remediating spoke1
+		&v1alpha1.ClusterGroupUpgrade{
+			ObjectMeta: v1.ObjectMeta{Name: "other", Namespace: "other"},
+			Status: v1alpha1.ClusterGroupUpgradeStatus{
+				Conditions: []v1.Condition{{Type: string(utils.ConditionTypes.Progressing), Status: v1.ConditionTrue,
+					Reason: string(utils.ConditionReasons.InProgress)}},
+				Status: v1alpha1.UpgradeStatus{CurrentBatchRemediationProgress: map[string]*v1alpha1.ClusterRemediationProgress{
+					"spoke1": {State: v1alpha1.InProgress},
+				}},
+			},
+		},
+		// A completed ClusterGroupUpgrade doesn't count
+		&v1alpha1.ClusterGroupUpgrade{
+			ObjectMeta: v1.ObjectMeta{Name: "completed", Namespace: "other"},
+			Status: v1alpha1.ClusterGroupUpgradeStatus{
+				Conditions: []v1.Condition{{Type: string(utils.ConditionTypes.Progressing), Status: v1.ConditionFalse,
+					Reason: string(utils.ConditionReasons.Completed)}},
+				Status: v1alpha1.UpgradeStatus{CurrentBatchRemediationProgress: map[string]*v1alpha1.ClusterRemediationProgress{
+					"spoke5": {State: v1alpha1.InProgress},
+				}},
+			},
+		},
+	}
+	fakeClient, err := getFakeClientFromObjects(objs...)
+	if err != nil {
+		t.Fatalf("error creating fake client: %v", err)
+	}
+	r := &ClusterGroupUpgradeReconciler{Client: fakeClient, Log: logr.Discard(), Scheme: testscheme}
+
+	cgu := &v1alpha1.ClusterGroupUpgrade{
+		ObjectMeta: v1.ObjectMeta{Name: "cgu", Namespace: "namespace"},
+		Spec: v1alpha1.ClusterGroupUpgradeSpec{
+			ManagedPolicies:     []string{"policy1"},
+			RemediationStrategy: &v1alpha1.RemediationStrategySpec{},
+		},
+		Status: v1alpha1.ClusterGroupUpgradeStatus{
+			Conditions: []v1.Condition{{Type: string(utils.ConditionTypes.Progressing), Status: v1.ConditionTrue,
+				Reason: string(utils.ConditionReasons.InProgress)}},
+			Status: v1alpha1.UpgradeStatus{CurrentBatchRemediationProgress: map[string]*v1alpha1.ClusterRemediationProgress{
+				"spoke2": {State: v1alpha1.InProgress},
+				"spoke3": {State: v1alpha1.NotStarted},
+				"spoke4": {State: v1alpha1.NotStarted},
+			}},
+		},
+	}
+
+	// No UpgradeConcurrencyPolicy
+	canStart, err := r.canStartClusterRemediation(context.TODO(), cgu, "spoke3", &clusterStartState{})
+	assert.NoError(t, err)
+	assert.True(t, canStart)
+
+	policy := &v1alpha1.UpgradeConcurrencyPolicy{
+		ObjectMeta: v1.ObjectMeta{Name: "fleet"},
+		Spec: v1alpha1.UpgradeConcurrencyPolicySpec{
+			MaxConcurrentClusters: 3,
+			Groups: []v1alpha1.ConcurrencyGroup{{
+				Name:                  "east",
+				Selector:              v1.LabelSelector{MatchLabels: map[string]string{"region": "east"}},
+				MaxConcurrentClusters: 2,
+			}},
+		},
+	}
+	assert.NoError(t, fakeClient.Create(context.TODO(), policy))
+
+	// spoke1 and spoke2 are in flight across both ClusterGroupUpgrades, the east group is full
+	canStart, err = r.canStartClusterRemediation(context.TODO(), cgu, "spoke3", &clusterStartState{})
+	assert.NoError(t, err)
+	assert.False(t, canStart)
+	progressingCondition := meta.FindStatusCondition(cgu.Status.Conditions, string(utils.ConditionTypes.Progressing))
+	assert.Equal(t, string(utils.ConditionReasons.WaitingForConcurrencySlot), progressingCondition.Reason)
+	assert.Equal(t, "Waiting for a concurrency slot: 2 clusters of group east are being remediated, the limit of UpgradeConcurrencyPolicy fleet is 2",
+		progressingCondition.Message)
+
+	// spoke4 is not in the east group and the global limit is not reached
+	canStart, err = r.canStartClusterRemediation(context.TODO(), cgu, "spoke4", &clusterStartState{})
+	assert.NoError(t, err)
+	assert.True(t, canStart)
+
+	// The global limit is reached
+	cgu.Status.Status.CurrentBatchRemediationProgress["spoke4"].State = v1alpha1.InProgress
+	state, err := r.getConcurrencyState(context.TODO(), cgu)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]bool{"spoke1": true}, state.otherInFlightClusters)
+	assert.Equal(t, "Waiting for a concurrency slot: 3 clusters are being remediated, the limit of UpgradeConcurrencyPolicy fleet is 3",
+		r.getConcurrencySlotMessage(cgu, "spoke5", state))
+
+	// A cluster already in flight keeps its slot
+	assert.Empty(t, r.getConcurrencySlotMessage(cgu, "spoke2", state))
+
+	// The clusters started since the state was read are counted
+	cgu.Status.Status.CurrentBatchRemediationProgress["spoke4"].State = v1alpha1.NotStarted
+	assert.Empty(t, r.getConcurrencySlotMessage(cgu, "spoke5", state))
+	cgu.Status.Status.CurrentBatchRemediationProgress["spoke4"].State = v1alpha1.InProgress
+	assert.NotEmpty(t, r.getConcurrencySlotMessage(cgu, "spoke5", state))
+}
@@ -0,0 +1,135 @@
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	utils "github.com/openshift-kni/cluster-group-upgrades-operator/controllers/utils"
+	ranv1alpha1 "github.com/openshift-kni/cluster-group-upgrades-operator/pkg/api/clustergroupupgrades/v1alpha1"
+	"k8s.io/apimachinery/pkg/api/meta"
+)
+
+// cguGoesFirst tells whether cgu goes before other when both remediate some of the same clusters:
+// the higher priority goes first, then the older ClusterGroupUpgrade
+func cguGoesFirst(cgu, other *ranv1alpha1.ClusterGroupUpgrade) bool {
+	if cgu.Spec.Priority != other.Spec.Priority {
+		return cgu.Spec.Priority > other.Spec.Priority
+	}
+	if !cgu.CreationTimestamp.Equal(&other.CreationTimestamp) {
+		return cgu.CreationTimestamp.Before(&other.CreationTimestamp)
+	}
+	if cgu.Namespace != other.Namespace {
+		return cgu.Namespace < other.Namespace
+	}
+	return cgu.Name < other.Name
+}
+
+// getPendingClusters returns the clusters of the remediation plan that are not done yet
+func getPendingClusters(clusterGroupUpgrade *ranv1alpha1.ClusterGroupUpgrade) map[string]bool {
+	pendingClusters := make(map[string]bool)
+	for _, clusterName := range utils.GetClustersListFromRemediationPlan(clusterGroupUpgrade) {
+		pendingClusters[clusterName] = true
+	}
+	for _, clusterState := range clusterGroupUpgrade.Status.Clusters {
+		delete(pendingClusters, clusterState.Name)
+	}
+	return pendingClusters
+}
+
+// isBlockedBy tells whether other lists cgu in its blocking CRs, in which case other can't go first
+func isBlockedBy(other, cgu *ranv1alpha1.ClusterGroupUpgrade) bool {
+	for _, blockingCR := range other.Spec.BlockingCRs {
+		if blockingCR.Name == cgu.Name && blockingCR.Namespace == cgu.Namespace {
+			return true
+		}
+	}
+	return false
+}
+
+// listConflictCandidates returns the other ClusterGroupUpgrades that may conflict with the given one: the ones in
+// progress, and unless inProgressOnly is set, the ones going first that are about to start
+func (r *ClusterGroupUpgradeReconciler) listConflictCandidates(
+	ctx context.Context, clusterGroupUpgrade *ranv1alpha1.ClusterGroupUpgrade, inProgressOnly bool) ([]*ranv1alpha1.ClusterGroupUpgrade, error) {
+	cgus := &ranv1alpha1.ClusterGroupUpgradeList{}
+	if err := r.List(ctx, cgus); err != nil {
+		return nil, err
+	}
+	var candidates []*ranv1alpha1.ClusterGroupUpgrade
+	for i := range cgus.Items {
+		other := &cgus.Items[i]
+		if other.Namespace == clusterGroupUpgrade.Namespace && other.Name == clusterGroupUpgrade.Name {
+			continue
+		}
+		if utils.IsStatusConditionPresent(other.Status.Conditions, string(utils.ConditionTypes.Succeeded)) {
+			continue
+		}
+		if meta.IsStatusConditionTrue(other.Status.Conditions, string(utils.ConditionTypes.Progressing)) {
+			candidates = append(candidates, other)
+			continue
+		}
+		if inProgressOnly || other.Spec.Enable == nil || !*other.Spec.Enable || other.Spec.DryRun ||
+			scheduledStartDelay(other) > 0 || isBlockedBy(other, clusterGroupUpgrade) {
+			continue
+		}
+		if cguGoesFirst(other, clusterGroupUpgrade) {
+			candidates = append(candidates, other)
+		}
+	}
+	return candidates, nil
+}
+
+// getConflictingCRs returns the active ClusterGroupUpgrades that the given one has to wait for before starting
+// because they remediate some of the same clusters
+func (r *ClusterGroupUpgradeReconciler) getConflictingCRs(
+	ctx context.Context, clusterGroupUpgrade *ranv1alpha1.ClusterGroupUpgrade) ([]string, error) {
+	candidates, err := r.listConflictCandidates(ctx, clusterGroupUpgrade, false)
+	if err != nil {
+		return nil, err
+	}
+	clusters := getPendingClusters(clusterGroupUpgrade)
+	var conflictingCRs []string
+	for _, other := range candidates {
+		for clusterName := range getPendingClusters(other) {
+			if clusters[clusterName] {
+				conflictingCRs = append(conflictingCRs, other.Namespace+"/"+other.Name)
+				break
+			}
+		}
+	}
+	sort.Strings(conflictingCRs)
+	r.Log.V(1).Info("[getConflictingCRs]", "conflictingCRs", conflictingCRs)
+	return conflictingCRs, nil
+}
+
+// getClusterConflicts returns, by cluster, the ClusterGroupUpgrades in progress that the remediation of the cluster
+// has to wait for because they remediate it too. They are listed once for all the clusters of the batch.
+func (r *ClusterGroupUpgradeReconciler) getClusterConflicts(
+	ctx context.Context, clusterGroupUpgrade *ranv1alpha1.ClusterGroupUpgrade) (map[string][]string, error) {
+	candidates, err := r.listConflictCandidates(ctx, clusterGroupUpgrade, true)
+	if err != nil {
+		return nil, err
+	}
+	conflicts := make(map[string][]string)
+	for _, other := range candidates {
+		goesFirst := cguGoesFirst(other, clusterGroupUpgrade)
+		for clusterName := range getPendingClusters(other) {
+			clusterProgress := other.Status.Status.CurrentBatchRemediationProgress[clusterName]
+			if goesFirst || (clusterProgress != nil && clusterProgress.State == ranv1alpha1.InProgress) {
+				conflicts[clusterName] = append(conflicts[clusterName], other.Namespace+"/"+other.Name)
+			}
+		}
+	}
+	return conflicts, nil
+}
+
+// getClusterConflictMessage returns why the remediation of a cluster has to wait for the ClusterGroupUpgrades in
+// progress that remediate it too, or an empty string if it can start
+func getClusterConflictMessage(conflicts map[string][]string, clusterName string) string {
+	conflictingCRs := conflicts[clusterName]
+	if len(conflictingCRs) == 0 {
+		return ""
+	}
+	sort.Strings(conflictingCRs)
+	return fmt.Sprintf("Cluster %s is waiting for conflicting CRs remediating it: %s", clusterName, conflictingCRs)
+}
@@ -0,0 +1,130 @@
+package controllers
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/openshift-kni/cluster-group-upgrades-operator/controllers/utils"
+	"github.com/openshift-kni/cluster-group-upgrades-operator/pkg/api/clustergroupupgrades/v1alpha1"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/api/meta"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func TestCguGoesFirst(t *testing.T) {
+	now := time.Now()
+	older := &v1alpha1.ClusterGroupUpgrade{ObjectMeta: v1.ObjectMeta{Name: "b", Namespace: "ns",
+		CreationTimestamp: v1.NewTime(now.Add(-time.Hour))}}
+	newer := &v1alpha1.ClusterGroupUpgrade{ObjectMeta: v1.ObjectMeta{Name: "a", Namespace: "ns",
+		CreationTimestamp: v1.NewTime(now)}}
+	assert.True(t, cguGoesFirst(older, newer))
+	assert.False(t, cguGoesFirst(newer, older))
+
+	newer.Spec.Priority = 10
+	assert.True(t, cguGoesFirst(newer, older))
+	assert.False(t, cguGoesFirst(older, newer))
+
+	// Same priority and creation time
+	older.Spec.Priority = 10
+	older.CreationTimestamp = newer.CreationTimestamp
+	assert.True(t, cguGoesFirst(newer, older))
+	assert.False(t, cguGoesFirst(older, newer))
+}
+
+func TestClusterGroupUpgradeReconciler_conflictingCRs(t *testing.T) {
+	enable := true
+	disable := false
+	now := time.Now()
+	inProgress := []v1.Condition{{Type: string(utils.ConditionTypes.Progressing), Status: v1.ConditionTrue,
+		Reason: string(utils.ConditionReasons.InProgress)}}
+	objs := []client.Object{
+		// In progress with a lower priority, done with spoke1 and remediating spoke2
+		&v1alpha1.ClusterGroupUpgrade{
+			ObjectMeta: v1.ObjectMeta{Name: "running", Namespace: "ns", CreationTimestamp: v1.NewTime(now)},
+			Spec:       v1alpha1.ClusterGroupUpgradeSpec{Enable: &enable, Priority: -1},
+			Status: v1alpha1.ClusterGroupUpgradeStatus{
+				Conditions:      inProgress,
+				RemediationPlan: [][]string{{"spoke1", "spoke2"}},
+				Clusters:        []v1alpha1.ClusterState{{Name: "spoke1", State: utils.ClusterRemediationComplete}},
+				Status: v1alpha1.UpgradeStatus{CurrentBatchRemediationProgress: map[string]*v1alpha1.ClusterRemediationProgress{
+					"spoke2": {State: v1alpha1.InProgress},
+				}},
+			},
+		},
+		// Not started with a higher priority
+		&v1alpha1.ClusterGroupUpgrade{
+			ObjectMeta: v1.ObjectMeta{Name: "urgent", Namespace: "ns", CreationTimestamp: v1.NewTime(now)},
+			Spec:       v1alpha1.ClusterGroupUpgradeSpec{Enable: &enable, Priority: 10},
+			Status:     v1alpha1.ClusterGroupUpgradeStatus{RemediationPlan: [][]string{{"spoke3"}}},
+		},
+		// Not started and not enabled
+		&v1alpha1.ClusterGroupUpgrade{
+			ObjectMeta: v1.ObjectMeta{Name: "disabled", Namespace: "ns", CreationTimestamp: v1.NewTime(now)},
+			Spec:       v1alpha1.ClusterGroupUpgradeSpec{Enable: &disable, Priority: 10},
+			Status:     v1alpha1.ClusterGroupUpgradeStatus{RemediationPlan: [][]string{{"spoke4"}}},
+		},
+		// Completed
+		&v1alpha1.ClusterGroupUpgrade{
+			ObjectMeta: v1.ObjectMeta{Name: "completed", Namespace: "ns", CreationTimestamp: v1.NewTime(now)},
+			Spec:       v1alpha1.ClusterGroupUpgradeSpec{Enable: &enable, Priority: 10},
+			Status: v1alpha1.ClusterGroupUpgradeStatus{
+				Conditions: []v1.Condition{{Type: string(utils.ConditionTypes.Succeeded), Status: v1.ConditionTrue,
+					Reason: string(utils.ConditionReasons.Completed)}},
+				RemediationPlan: [][]string{{"spoke5"}},
+			},
+		},
+	}
+	fakeClient, err := getFakeClientFromObjects(objs...)
+	if err != nil {
+		t.Fatalf("error creating fake client: %v", err)
+	}
+	r := &ClusterGroupUpgradeReconciler{Client: fakeClient, Log: logr.Discard(), Scheme: testscheme}
+
+	cgu := &v1alpha1.ClusterGroupUpgrade{
+		ObjectMeta: v1.ObjectMeta{Name: "cgu", Namespace: "ns", CreationTimestamp: v1.NewTime(now)},
+		Spec:       v1alpha1.ClusterGroupUpgradeSpec{Enable: &enable, RemediationStrategy: &v1alpha1.RemediationStrategySpec{}},
+		Status: v1alpha1.ClusterGroupUpgradeStatus{
+			RemediationPlan: [][]string{{"spoke1", "spoke4", "spoke5"}},
+		},
+	}
+	conflictingCRs, err := r.getConflictingCRs(context.TODO(), cgu)
+	assert.NoError(t, err)
+	assert.Empty(t, conflictingCRs)
+
+	cgu.Status.RemediationPlan = [][]string{{"spoke1", "spoke2", "spoke3"}}
+	conflictingCRs, err = r.getConflictingCRs(context.TODO(), cgu)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"ns/running", "ns/urgent"}, conflictingCRs)
+
+	// A CR blocked by this one doesn't go first
+	urgent := &v1alpha1.ClusterGroupUpgrade{}
+	assert.NoError(t, fakeClient.Get(context.TODO(), client.ObjectKey{Name: "urgent", Namespace: "ns"}, urgent))
+	urgent.Spec.BlockingCRs = []v1alpha1.BlockingCR{{Name: "cgu", Namespace: "ns"}}
+	assert.NoError(t, fakeClient.Update(context.TODO(), urgent))
+	conflictingCRs, err = r.getConflictingCRs(context.TODO(), cgu)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"ns/running"}, conflictingCRs)
+
+	// Only the clusters remediated by a CR in progress wait
+	cgu.Spec.ConflictAction = v1alpha1.ConflictAction.WaitForOverlappingClusters
+	cgu.Status.Conditions = inProgress
+	conflicts, err := r.getClusterConflicts(context.TODO(), cgu)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string][]string{"spoke2": {"ns/running"}}, conflicts)
+	canStart, err := r.canStartClusterRemediation(context.TODO(), cgu, "spoke2", &clusterStartState{})
+	assert.NoError(t, err)
+	assert.False(t, canStart)
+	progressingCondition := meta.FindStatusCondition(cgu.Status.Conditions, string(utils.ConditionTypes.Progressing))
+	assert.Equal(t, string(utils.ConditionReasons.WaitingForConflictingCR), progressingCondition.Reason)
+	assert.Equal(t, "Cluster spoke2 is waiting for conflicting CRs remediating it: [ns/running]", progressingCondition.Message)
+
+	canStart, err = r.canStartClusterRemediation(context.TODO(), cgu, "spoke1", &clusterStartState{})
+	assert.NoError(t, err)
+	assert.True(t, canStart)
+	canStart, err = r.canStartClusterRemediation(context.TODO(), cgu, "spoke3", &clusterStartState{})
+	assert.NoError(t, err)
+	assert.True(t, canStart)
+}
@@ -0,0 +1,71 @@
+package controllers
+
+import (
+	"fmt"
+
+	utils "github.com/openshift-kni/cluster-group-upgrades-operator/controllers/utils"
+	ranv1alpha1 "github.com/openshift-kni/cluster-group-upgrades-operator/pkg/api/clustergroupupgrades/v1alpha1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// buildDryRunPlan reports in status what the CGU would remediate, based on the remediation plan already built
+// from the selected clusters. Nothing is created on the hub or on the clusters.
+func (r *ClusterGroupUpgradeReconciler) buildDryRunPlan(
+	clusterGroupUpgrade *ranv1alpha1.ClusterGroupUpgrade, clusters, compliantClusters []string,
+	managedPolicies []*unstructured.Unstructured) error {
+
+	// Get the subscriptions whose InstallPlans would be approved for each policy
+	subscriptions := make(map[string][]string, len(managedPolicies))
+	for _, managedPolicy := range managedPolicies {
+		monitoredObjects, err := r.getMonitoredObjects(managedPolicy)
+		if err != nil {
+			return err
+		}
+		for _, object := range monitoredObjects {
+			if object.Kind == utils.SubscriptionGroupVersionKind().Kind {
+				subscriptions[managedPolicy.GetName()] = append(
+					subscriptions[managedPolicy.GetName()], fmt.Sprintf("%s/%s", *object.Namespace, object.Name))
+			}
+		}
+	}
+
+	dryRun := &ranv1alpha1.DryRunStatus{
+		SelectedClusters:  clusters,
+		CompliantClusters: compliantClusters,
+		Batches:           clusterGroupUpgrade.Status.RemediationPlan,
+	}
+	for batchIndex, batch := range clusterGroupUpgrade.Status.RemediationPlan {
+		for _, cluster := range batch {
+			clusterPlan := ranv1alpha1.DryRunClusterPlan{Name: cluster, BatchIndex: batchIndex}
+			for _, managedPolicy := range managedPolicies {
+				if r.getClusterComplianceWithPolicy(cluster, managedPolicy) != utils.ClusterStatusNonCompliant {
+					continue
+				}
+				clusterPlan.NonCompliantPolicies = append(clusterPlan.NonCompliantPolicies, managedPolicy.GetName())
+				clusterPlan.SubscriptionsToApprove = append(clusterPlan.SubscriptionsToApprove, subscriptions[managedPolicy.GetName()]...)
+			}
+			dryRun.Clusters = append(dryRun.Clusters, clusterPlan)
+		}
+	}
+	// Keep the plan and its computation time as is when nothing changed, so that the status isn't rewritten on
+	// every reconciliation
+	if previous := clusterGroupUpgrade.Status.DryRun; previous != nil {
+		dryRun.ComputedAt = previous.ComputedAt
+	}
+	if !equality.Semantic.DeepEqual(dryRun, clusterGroupUpgrade.Status.DryRun) {
+		dryRun.ComputedAt = metav1.Now()
+		clusterGroupUpgrade.Status.DryRun = dryRun
+	}
+
+	utils.SetStatusCondition(
+		&clusterGroupUpgrade.Status.Conditions,
+		utils.ConditionTypes.Progressing,
+		utils.ConditionReasons.DryRun,
+		metav1.ConditionFalse,
+		fmt.Sprintf("Dry run: %d clusters would be remediated in %d batches, %d clusters are already compliant",
+			len(dryRun.Clusters), len(dryRun.Batches), len(compliantClusters)),
+	)
+	return nil
+}
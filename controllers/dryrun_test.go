@@ -0,0 +1,103 @@
+package controllers
+
+import (
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/openshift-kni/cluster-group-upgrades-operator/controllers/utils"
+	"github.com/openshift-kni/cluster-group-upgrades-operator/pkg/api/clustergroupupgrades/v1alpha1"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/api/meta"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func newDryRunTestPolicy(name string, objectTemplates []interface{}, compliance map[string]string) *unstructured.Unstructured {
+	var clusterStatus []interface{}
+	for cluster, compliant := range compliance {
+		clusterStatus = append(clusterStatus, map[string]interface{}{"clustername": cluster, "compliant": compliant})
+	}
+	return &unstructured.Unstructured{Object: map[string]interface{}{
+		"metadata": map[string]interface{}{"name": name, "namespace": "default"},
+		"spec": map[string]interface{}{
+			"policy-templates": []interface{}{
+				map[string]interface{}{
+					"objectDefinition": map[string]interface{}{
+						"spec": map[string]interface{}{"object-templates": objectTemplates},
+					},
+				},
+			},
+		},
+		"status": map[string]interface{}{"status": clusterStatus},
+	}}
+}
+
+func TestClusterGroupUpgradeReconciler_buildDryRunPlan(t *testing.T) {
+	r := &ClusterGroupUpgradeReconciler{Log: logr.Discard()}
+
+	namespacePolicy := newDryRunTestPolicy("namespace-policy", []interface{}{
+		map[string]interface{}{
+			"complianceType": "musthave",
+			"objectDefinition": map[string]interface{}{
+				"apiVersion": "v1",
+				"kind":       "Namespace",
+				"metadata":   map[string]interface{}{"name": "ns"},
+			},
+		},
+	}, map[string]string{"spoke1": "NonCompliant", "spoke2": "NonCompliant", "spoke3": "Compliant"})
+	subscriptionPolicy := newDryRunTestPolicy("subscription-policy", []interface{}{
+		map[string]interface{}{
+			"complianceType": "musthave",
+			"objectDefinition": map[string]interface{}{
+				"apiVersion": "operators.coreos.com/v1alpha1",
+				"kind":       "Subscription",
+				"metadata":   map[string]interface{}{"name": "sub", "namespace": "operators"},
+			},
+		},
+	}, map[string]string{"spoke1": "Compliant", "spoke2": "NonCompliant", "spoke3": "Compliant"})
+
+	cgu := &v1alpha1.ClusterGroupUpgrade{
+		ObjectMeta: v1.ObjectMeta{Name: "cgu", Namespace: "namespace"},
+		Spec:       v1alpha1.ClusterGroupUpgradeSpec{DryRun: true},
+		Status: v1alpha1.ClusterGroupUpgradeStatus{
+			RemediationPlan: [][]string{{"spoke1"}, {"spoke2"}},
+		},
+	}
+	err := r.buildDryRunPlan(cgu, []string{"spoke1", "spoke2", "spoke3"}, []string{"spoke3"},
+		[]*unstructured.Unstructured{namespacePolicy, subscriptionPolicy})
+	assert.NoError(t, err)
+
+	assert.Equal(t, []string{"spoke1", "spoke2", "spoke3"}, cgu.Status.DryRun.SelectedClusters)
+	assert.Equal(t, []string{"spoke3"}, cgu.Status.DryRun.CompliantClusters)
+	assert.Equal(t, [][]string{{"spoke1"}, {"spoke2"}}, cgu.Status.DryRun.Batches)
+	assert.Equal(t, []v1alpha1.DryRunClusterPlan{
+		{Name: "spoke1", BatchIndex: 0, NonCompliantPolicies: []string{"namespace-policy"}},
+		{
+			Name: "spoke2", BatchIndex: 1,
+			NonCompliantPolicies:   []string{"namespace-policy", "subscription-policy"},
+			SubscriptionsToApprove: []string{"operators/sub"},
+		},
+	}, cgu.Status.DryRun.Clusters)
+
+	computedAt := v1.NewTime(time.Now().Add(-time.Hour))
+	cgu.Status.DryRun.ComputedAt = computedAt
+
+	// The plan is kept as is when it doesn't change
+	err = r.buildDryRunPlan(cgu, []string{"spoke1", "spoke2", "spoke3"}, []string{"spoke3"},
+		[]*unstructured.Unstructured{namespacePolicy, subscriptionPolicy})
+	assert.NoError(t, err)
+	assert.Equal(t, computedAt, cgu.Status.DryRun.ComputedAt)
+
+	// and recomputed when it does
+	cgu.Status.RemediationPlan = [][]string{{"spoke1", "spoke2"}}
+	err = r.buildDryRunPlan(cgu, []string{"spoke1", "spoke2", "spoke3"}, []string{"spoke3"},
+		[]*unstructured.Unstructured{namespacePolicy, subscriptionPolicy})
+	assert.NoError(t, err)
+	assert.True(t, computedAt.Before(&cgu.Status.DryRun.ComputedAt))
+	assert.Equal(t, [][]string{{"spoke1", "spoke2"}}, cgu.Status.DryRun.Batches)
+
+	progressingCondition := meta.FindStatusCondition(cgu.Status.Conditions, string(utils.ConditionTypes.Progressing))
+	assert.Equal(t, string(utils.ConditionReasons.DryRun), progressingCondition.Reason)
+	assert.Equal(t, v1.ConditionFalse, progressingCondition.Status)
+}
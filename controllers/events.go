@@ -33,6 +33,16 @@ import (
 // CguTimedout (Reason)
 // - RemediationTimeout (Action): When remediation is timed out for the whole ClusterGroupUpgrade.
 // - RemediationInBatchTimeout (Action): When remediation is timed out for a batch of the ClusterGroupUpgrade.
+// - RemediationInClusterTimeout (Action): When remediation is timed out for a cluster of the ClusterGroupUpgrade.
+// CguPaused (Reason)
+// - RemediationPaused (Action): When an in-progress ClusterGroupUpgrade is paused by setting its enable field to false.
+// CguResumed (Reason)
+// - RemediationResumed (Action): When a paused ClusterGroupUpgrade is resumed by setting its enable field back to true.
+// CguClustersUnavailable (Reason)
+// - RemediationInClustersSkipped (Action): When unavailable clusters are removed from a batch before it starts.
+// - RemediationInClustersDeferred (Action): When unavailable clusters are moved to the end of the remediation plan.
+// CguPreflightFailed (Reason)
+// - RemediationInClusterSkipped (Action): When a cluster failing its preflight checks is removed from its batch.
 // CguValidationFailure (Reason)
 // - RemediationOnHoldDueToValidationFailure (Action): When remediation is on hold due to a validation failure.
 
@@ -42,6 +52,11 @@ const (
 	CGUEventReasonStarted  = "CguStarted"
 	CGUEventReasonSuccess  = "CguSuccess"
 	CGUEventReasonTimedout = "CguTimedout"
+	CGUEventReasonPaused   = "CguPaused"
+	CGUEventReasonResumed  = "CguResumed"
+
+	CGUEventReasonClustersUnavailable = "CguClustersUnavailable"
+	CGUEventReasonPreflightFailed     = "CguPreflightFailed"
 
 	CGUEventReasonValidationFailure = "CguValidationFailure"
 )
@@ -55,9 +70,15 @@ const (
 	CGUEventActionStartBatchRemediation      = "RemediationInBatchStarted"
 	CGUEventActionCompleteBatchRemediation   = "RemediationInBatchCompleted"
 	CGUEventActionBatchRemediationTimeout    = "RemediationInBatchTimeout"
+	CGUEventActionClusterRemediationTimeout  = "RemediationInClusterTimeout"
 	CGUEventActionStartClusterRemediation    = "RemediationInClusterStarted"
 	CGUEventActionCompleteClusterRemediation = "RemediationInClusterCompleted"
 	CGUEventActionValidate                   = "RemediationOnHoldDueToValidationFailure"
+	CGUEventActionPauseRemediation           = "RemediationPaused"
+	CGUEventActionResumeRemediation          = "RemediationResumed"
+	CGUEventActionSkipClustersRemediation    = "RemediationInClustersSkipped"
+	CGUEventActionDeferClustersRemediation   = "RemediationInClustersDeferred"
+	CGUEventActionSkipClusterRemediation     = "RemediationInClusterSkipped"
 )
 
 // CGU Event Messages
@@ -67,14 +88,20 @@ const (
 	CGUEventMsgFmtUpgradeSuccess    = "ClusterGroupUpgrade %s succeeded remediating policies"
 	CGUEventMsgFmtUpgradeTimedout   = "ClusterGroupUpgrade %s timed-out remediating policies"
 	CGUEventMsgFmtValidationFailure = "ClusterGroupUpgrade %s: validation failure (%s): %s"
+	CGUEventMsgFmtPaused            = "ClusterGroupUpgrade %s paused at batch index %d"
+	CGUEventMsgFmtResumed           = "ClusterGroupUpgrade %s resumed at batch index %d"
 
 	CGUEventMsgFmtBatchUpgradeStarted  = "ClusterGroupUpgrade %s: batch index %d upgrade started"
 	CGUEventMsgFmtBatchUpgradeSuccess  = "ClusterGroupUpgrade %s: all clusters in the batch index %d are compliant with managed policies"
 	CGUEventMsgFmtBatchUpgradeTimedout = "ClusterGroupUpgrade %s: some clusters in the batch index %d timed out remediating policies"
-
-	CGUEventMsgFmtClusterUpgradeSuccess = "ClusterGroupUpgrade %s: cluster %s upgrade finished successfully"
-	CGUEventMsgFmtClusterUpgradeStarted = "ClusterGroupUpgrade %s: cluster %s upgrade started"
-	// CGUEventMsgFmtClusterUpgradeTimedout = "ClusterGroupUpgrade %s: cluster %s timed out remediating policies"
+	CGUEventMsgFmtClustersSkipped      = "ClusterGroupUpgrade %s: unavailable clusters skipped in the batch index %d: %s"
+	CGUEventMsgFmtClustersDeferred     = "ClusterGroupUpgrade %s: unavailable clusters deferred from the batch index %d: %s"
+
+	CGUEventMsgFmtClusterUpgradeSuccess       = "ClusterGroupUpgrade %s: cluster %s upgrade finished successfully"
+	CGUEventMsgFmtClusterUpgradeStarted       = "ClusterGroupUpgrade %s: cluster %s upgrade started"
+	CGUEventMsgFmtClusterPreflightFailed      = "ClusterGroupUpgrade %s: cluster %s skipped, preflight checks failed: %s"
+	CGUEventMsgFmtClusterUpgradeTimedout      = "ClusterGroupUpgrade %s: cluster %s timed out remediating policies"
+	CGUEventMsgFmtClusterManifestWorkTimedout = "ClusterGroupUpgrade %s: cluster %s timed out applying manifestworks"
 )
 
 // CGU Validation Failure literals for the event's message.
@@ -93,10 +120,19 @@ const (
 	CGUEventAnnotationKeyBatchClustersList     = CGUEventAnnotationKeyPrefix + "/batch-clusters"
 	CGUEventAnnotationKeyBatchClustersCount    = CGUEventAnnotationKeyPrefix + "/batch-clusters-count"
 	CGUEventAnnotationKeyClusterName           = CGUEventAnnotationKeyPrefix + "/cluster-name"
+	CGUEventAnnotationKeyFailureReason         = CGUEventAnnotationKeyPrefix + "/failure-reason"
 	CGUEventAnnotationKeyTimedoutClustersList  = CGUEventAnnotationKeyPrefix + "/timedout-clusters"
 	CGUEventAnnotationKeyTimedoutClustersCount = CGUEventAnnotationKeyPrefix + "/timedout-clusters-count"
 	CGUEventAnnotationKeyTotalBatchesCount     = CGUEventAnnotationKeyPrefix + "/total-batches-count"
 	CGUEventAnnotationKeyTotalClustersCount    = CGUEventAnnotationKeyPrefix + "/total-clusters-count"
+	CGUEventAnnotationKeyUnavailableClusters   = CGUEventAnnotationKeyPrefix + "/unavailable-clusters"
+	CGUEventAnnotationKeyUnavailableCount      = CGUEventAnnotationKeyPrefix + "/unavailable-clusters-count"
+
+	// Step a timed out cluster was stuck at
+	CGUEventAnnotationKeyCurrentPolicy             = CGUEventAnnotationKeyPrefix + "/current-policy"
+	CGUEventAnnotationKeyCurrentPolicyStatus       = CGUEventAnnotationKeyPrefix + "/current-policy-status"
+	CGUEventAnnotationKeyCurrentManifestWork       = CGUEventAnnotationKeyPrefix + "/current-manifestwork"
+	CGUEventAnnotationKeyCurrentManifestWorkStatus = CGUEventAnnotationKeyPrefix + "/current-manifestwork-status"
 
 	// Validation failures
 	CGUEventAnnotationKeyMissingClustersList   = CGUEventAnnotationKeyPrefix + "/missing-clusters"
@@ -145,6 +181,7 @@ type Emitter struct {
 	scheme     *runtime.Scheme
 	controller string
 	instance   string
+	notifier   *Notifier
 }
 
 // NewEmitter returns an Emitter that reports events under the given controller name.
@@ -159,6 +196,12 @@ func NewEmitter(c client.Client, scheme *runtime.Scheme, controller string) *Emi
 	}
 }
 
+// WithNotifier makes the Emitter also send the events to the UpgradeNotificationEndpoints with the Notifier.
+func (e *Emitter) WithNotifier(n *Notifier) *Emitter {
+	e.notifier = n
+	return e
+}
+
 // Emit creates a single events/v1 Event resource in the cluster.
 //
 // obj is the object the event is about (the "regarding" object).
@@ -169,6 +212,9 @@ func NewEmitter(c client.Client, scheme *runtime.Scheme, controller string) *Emi
 // note is the human-readable event message.
 // related is an optional secondary object (e.g. the ManagedCluster being remediated).
 //
+// When a Notifier is set, the event is also sent to the matching UpgradeNotificationEndpoints,
+// even if the Event resource could not be created.
+//
 // Safe to call on a nil receiver — the call is silently ignored.
 func (e *Emitter) Emit(ctx context.Context, obj runtime.Object, annotations map[string]string,
 	eventType, reason, action, note string, related *corev1.ObjectReference) error {
@@ -210,7 +256,22 @@ func (e *Emitter) Emit(ctx context.Context, obj runtime.Object, annotations map[
 		Related: related,
 	}
 
-	return e.client.Create(ctx, ev)
+	err = e.client.Create(ctx, ev)
+
+	e.notifier.Notify(ctx, &Notification{
+		Time:        metav1.NewTime(ev.EventTime.Time),
+		Kind:        ref.Kind,
+		Namespace:   ref.Namespace,
+		Name:        ref.Name,
+		Type:        eventType,
+		Reason:      reason,
+		Action:      action,
+		Note:        note,
+		Annotations: annotations,
+		Related:     related,
+	})
+
+	return err
 }
 
 // emitEvent is a helper that calls the Emitter and logs failures.
@@ -409,6 +470,34 @@ func (r *ClusterGroupUpgradeReconciler) sendEventCGUBatchUpgradeTimedout(ctx con
 	)
 }
 
+func (r *ClusterGroupUpgradeReconciler) sendEventCGUClustersUnavailable(
+	ctx context.Context, cgu *cguv1alpha1.ClusterGroupUpgrade, unavailableClusters []string, deferred bool) {
+
+	msgFmt, action := CGUEventMsgFmtClustersSkipped, CGUEventActionSkipClustersRemediation
+	if deferred {
+		msgFmt, action = CGUEventMsgFmtClustersDeferred, CGUEventActionDeferClustersRemediation
+	}
+	evMsg := fmt.Sprintf(msgFmt, cgu.Name, cgu.Status.Status.CurrentBatch, strings.Join(unavailableClusters, ","))
+
+	evAnns := map[string]string{
+		CGUEventAnnotationKeyEvType:              CGUAnnEventBatchUpgrade,
+		CGUEventAnnotationKeyUnavailableCount:    fmt.Sprint(len(unavailableClusters)),
+		CGUEventAnnotationKeyUnavailableClusters: strings.Join(unavailableClusters, ","),
+		CGUEventAnnotationKeyTotalClustersCount:  fmt.Sprint(getTotalClustersNum(cgu)),
+	}
+
+	truncateAnnotations(evAnns, maxEventAnnsSize)
+
+	r.emitEvent(ctx, cgu,
+		evAnns,
+		corev1.EventTypeWarning,
+		CGUEventReasonClustersUnavailable,
+		action,
+		evMsg,
+		nil,
+	)
+}
+
 func (r *ClusterGroupUpgradeReconciler) sendEventCGUClusterUpgradeStarted(ctx context.Context, cgu *cguv1alpha1.ClusterGroupUpgrade, clusterName string) {
 	evMsg := fmt.Sprintf(CGUEventMsgFmtClusterUpgradeStarted, cgu.Name, clusterName)
 
@@ -461,21 +550,117 @@ func (r *ClusterGroupUpgradeReconciler) sendEventCGUClusterUpgradeSuccess(ctx co
 	)
 }
 
-// func (r *ClusterGroupUpgradeReconciler) sendEventCGUClusterUpgradeTimedout(cgu *cguv1alpha1.ClusterGroupUpgrade, clusterName string) {
-// 	evMsg := fmt.Sprintf(CGUEventMsgFmtClusterUpgradeTimedout, cgu.Name, clusterName)
+func (r *ClusterGroupUpgradeReconciler) sendEventCGUClusterPreflightFailed(
+	ctx context.Context, cgu *cguv1alpha1.ClusterGroupUpgrade, clusterName, reason string) {
+	evMsg := fmt.Sprintf(CGUEventMsgFmtClusterPreflightFailed, cgu.Name, clusterName, reason)
 
-// 	evAnns := map[string]string{
-// 		CGUEventAnnotationKeyEvType:      CGUAnnEventClusterUpgrade,
-// 		CGUEventAnnotationKeyClusterName: clusterName,
-// 	}
+	evAnns := map[string]string{
+		CGUEventAnnotationKeyEvType:        CGUAnnEventClusterUpgrade,
+		CGUEventAnnotationKeyClusterName:   clusterName,
+		CGUEventAnnotationKeyFailureReason: reason,
+	}
 
-// 	truncateAnnotations(evAnns, maxEventAnnsSize)
+	truncateAnnotations(evAnns, maxEventAnnsSize)
+
+	managedClusterRef := &corev1.ObjectReference{
+		APIVersion: clusterv1.GroupVersion.String(),
+		Kind:       "ManagedCluster",
+		Name:       clusterName,
+	}
 
-// 	r.Recorder.AnnotatedEventf(cgu,
-// 		evAnns,
-// 		corev1.EventTypeNormal,
-// 		CGUEventReasonTimedout, evMsg)
-// }
+	r.emitEvent(ctx, cgu,
+		evAnns,
+		corev1.EventTypeWarning,
+		CGUEventReasonPreflightFailed,
+		CGUEventActionSkipClusterRemediation,
+		evMsg,
+		managedClusterRef,
+	)
+}
+
+func (r *ClusterGroupUpgradeReconciler) sendEventCGUPaused(ctx context.Context, cgu *cguv1alpha1.ClusterGroupUpgrade) {
+	evMsg := fmt.Sprintf(CGUEventMsgFmtPaused, cgu.Name, cgu.Status.Status.CurrentBatch)
+
+	evAnns := map[string]string{
+		CGUEventAnnotationKeyEvType:             CGUAnnEventGlobalUpgrade,
+		CGUEventAnnotationKeyTotalClustersCount: fmt.Sprint(getTotalClustersNum(cgu)),
+		CGUEventAnnotationKeyTotalBatchesCount:  fmt.Sprint(len(cgu.Status.RemediationPlan)),
+	}
+
+	r.emitEvent(ctx, cgu,
+		evAnns,
+		corev1.EventTypeNormal,
+		CGUEventReasonPaused,
+		CGUEventActionPauseRemediation,
+		evMsg,
+		nil,
+	)
+}
+
+func (r *ClusterGroupUpgradeReconciler) sendEventCGUResumed(ctx context.Context, cgu *cguv1alpha1.ClusterGroupUpgrade) {
+	evMsg := fmt.Sprintf(CGUEventMsgFmtResumed, cgu.Name, cgu.Status.Status.CurrentBatch)
+
+	evAnns := map[string]string{
+		CGUEventAnnotationKeyEvType:             CGUAnnEventGlobalUpgrade,
+		CGUEventAnnotationKeyTotalClustersCount: fmt.Sprint(getTotalClustersNum(cgu)),
+		CGUEventAnnotationKeyTotalBatchesCount:  fmt.Sprint(len(cgu.Status.RemediationPlan)),
+	}
+
+	r.emitEvent(ctx, cgu,
+		evAnns,
+		corev1.EventTypeNormal,
+		CGUEventReasonResumed,
+		CGUEventActionResumeRemediation,
+		evMsg,
+		nil,
+	)
+}
+
+// sendEventCGUClusterUpgradeTimedout reports a cluster that timed out with the policy or manifestwork it was stuck at,
+// taken from its final state
+func (r *ClusterGroupUpgradeReconciler) sendEventCGUClusterUpgradeTimedout(
+	ctx context.Context, cgu *cguv1alpha1.ClusterGroupUpgrade, clusterState *cguv1alpha1.ClusterState) {
+
+	evMsg := fmt.Sprintf(CGUEventMsgFmtClusterUpgradeTimedout, cgu.Name, clusterState.Name)
+	if cgu.RolloutType() == cguv1alpha1.RolloutTypes.ManifestWork {
+		evMsg = fmt.Sprintf(CGUEventMsgFmtClusterManifestWorkTimedout, cgu.Name, clusterState.Name)
+	}
+
+	evAnns := map[string]string{
+		CGUEventAnnotationKeyEvType:      CGUAnnEventClusterUpgrade,
+		CGUEventAnnotationKeyClusterName: clusterState.Name,
+	}
+	if clusterState.Reason != "" {
+		evAnns[CGUEventAnnotationKeyFailureReason] = clusterState.Reason
+	}
+	if clusterState.CurrentPolicy != nil {
+		evAnns[CGUEventAnnotationKeyCurrentPolicy] = clusterState.CurrentPolicy.Name
+		evAnns[CGUEventAnnotationKeyCurrentPolicyStatus] = clusterState.CurrentPolicy.Status
+	}
+	if clusterState.CurrentManifestWork != nil {
+		evAnns[CGUEventAnnotationKeyCurrentManifestWork] = clusterState.CurrentManifestWork.Name
+		if msg := utils.GetConditionMessageFromManifestWorkStatus(clusterState.CurrentManifestWork); msg != "" {
+			evAnns[CGUEventAnnotationKeyCurrentManifestWorkStatus] = msg
+		}
+	}
+
+	truncateAnnotations(evAnns, maxEventAnnsSize)
+
+	managedClusterRef := &corev1.ObjectReference{
+		APIVersion: clusterv1.GroupVersion.String(),
+		Kind:       "ManagedCluster",
+		Name:       clusterState.Name,
+	}
+
+	r.emitEvent(ctx, cgu,
+		evAnns,
+		corev1.EventTypeWarning,
+		CGUEventReasonTimedout,
+		CGUEventActionClusterRemediationTimeout,
+		evMsg,
+		managedClusterRef,
+	)
+}
 
 func (r *ClusterGroupUpgradeReconciler) sendEventCGUValidationFailureMissingClusters(ctx context.Context, cgu *cguv1alpha1.ClusterGroupUpgrade, clusterNames []string) {
 	clusterNamesStr := strings.Join(clusterNames, ",")
@@ -552,12 +737,15 @@ func (r *ClusterGroupUpgradeReconciler) sendEventCGUVPoliciesValidationFailure(c
 // nolint: unparam
 func truncateAnnotations(anns map[string]string, maxSize int) {
 	canBeTruncatedAnnKeys := map[string]bool{
-		CGUEventAnnotationKeyBatchClustersList:     true,
-		CGUEventAnnotationKeyTimedoutClustersList:  true,
-		CGUEventAnnotationKeyMissingClustersList:   true,
-		CGUEventAnnotationKeyMissingPoliciesList:   true,
-		CGUEventAnnotationKeyInvalidPoliciesList:   true,
-		CGUEventAnnotationKeyAmbiguousPoliciesList: true,
+		CGUEventAnnotationKeyBatchClustersList:         true,
+		CGUEventAnnotationKeyTimedoutClustersList:      true,
+		CGUEventAnnotationKeyUnavailableClusters:       true,
+		CGUEventAnnotationKeyFailureReason:             true,
+		CGUEventAnnotationKeyCurrentManifestWorkStatus: true,
+		CGUEventAnnotationKeyMissingClustersList:       true,
+		CGUEventAnnotationKeyMissingPoliciesList:       true,
+		CGUEventAnnotationKeyInvalidPoliciesList:       true,
+		CGUEventAnnotationKeyAmbiguousPoliciesList:     true,
 	}
 
 	var totalAnnsSize int64
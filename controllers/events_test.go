@@ -4,11 +4,13 @@ import (
 	"testing"
 
 	"github.com/go-logr/logr"
+	"github.com/openshift-kni/cluster-group-upgrades-operator/controllers/utils"
 	ranv1alpha1 "github.com/openshift-kni/cluster-group-upgrades-operator/pkg/api/clustergroupupgrades/v1alpha1"
 	"github.com/stretchr/testify/assert"
 	eventsv1 "k8s.io/api/events/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	mwv1 "open-cluster-management.io/api/work/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 )
@@ -480,3 +482,82 @@ func Test_sendEventCGUBatchUpgradeTimedout_DeterministicClusterOrder(t *testing.
 			"Event %d timedout-clusters annotation should match event 0 (deterministic order)", i)
 	}
 }
+
+func Test_sendEventCGUClusterUpgradeTimedout(t *testing.T) {
+	tests := []struct {
+		name            string
+		manifestWorks   []string
+		clusterState    ranv1alpha1.ClusterState
+		wantMsg         string
+		wantAnnotations map[string]string
+	}{
+		{
+			name: "stuck at a policy",
+			clusterState: ranv1alpha1.ClusterState{
+				Name: "spoke1", State: utils.ClusterRemediationTimedout,
+				Reason:        "Policy policy2 was not completed within its timeout of 10 minutes",
+				CurrentPolicy: &ranv1alpha1.PolicyStatus{Name: "policy2", Status: utils.ClusterStatusNonCompliant},
+			},
+			wantMsg: "ClusterGroupUpgrade test-cgu: cluster spoke1 timed out remediating policies",
+			wantAnnotations: map[string]string{
+				CGUEventAnnotationKeyEvType:              CGUAnnEventClusterUpgrade,
+				CGUEventAnnotationKeyClusterName:         "spoke1",
+				CGUEventAnnotationKeyFailureReason:       "Policy policy2 was not completed within its timeout of 10 minutes",
+				CGUEventAnnotationKeyCurrentPolicy:       "policy2",
+				CGUEventAnnotationKeyCurrentPolicyStatus: utils.ClusterStatusNonCompliant,
+			},
+		},
+		{
+			name:          "stuck at a manifestwork",
+			manifestWorks: []string{"ibu-prep"},
+			clusterState: ranv1alpha1.ClusterState{
+				Name: "spoke2", State: utils.ClusterRemediationTimedout,
+				CurrentManifestWork: &ranv1alpha1.ManifestWorkStatus{
+					Name: "ibu-prep",
+					Status: mwv1.ManifestResourceStatus{Manifests: []mwv1.ManifestCondition{{
+						Conditions: []metav1.Condition{{Type: "Applied", Status: "False", Message: "failed to apply"}},
+					}}},
+				},
+			},
+			wantMsg: "ClusterGroupUpgrade test-cgu: cluster spoke2 timed out applying manifestworks",
+			wantAnnotations: map[string]string{
+				CGUEventAnnotationKeyEvType:                    CGUAnnEventClusterUpgrade,
+				CGUEventAnnotationKeyClusterName:               "spoke2",
+				CGUEventAnnotationKeyCurrentManifestWork:       "ibu-prep",
+				CGUEventAnnotationKeyCurrentManifestWorkStatus: "failed to apply",
+			},
+		},
+		{
+			name:         "not started",
+			clusterState: ranv1alpha1.ClusterState{Name: "spoke3", State: utils.ClusterRemediationTimedout},
+			wantMsg:      "ClusterGroupUpgrade test-cgu: cluster spoke3 timed out remediating policies",
+			wantAnnotations: map[string]string{
+				CGUEventAnnotationKeyEvType:      CGUAnnEventClusterUpgrade,
+				CGUEventAnnotationKeyClusterName: "spoke3",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reconciler, fakeClient := newTestReconciler(t)
+			cgu := newTestCGU()
+			cgu.Spec.ManifestWorkTemplates = tt.manifestWorks
+
+			reconciler.sendEventCGUClusterUpgradeTimedout(t.Context(), cgu, &tt.clusterState)
+
+			var eventList eventsv1.EventList
+			assert.NoError(t, fakeClient.List(t.Context(), &eventList))
+			assert.Len(t, eventList.Items, 1)
+
+			ev := eventList.Items[0]
+			assert.Equal(t, "Warning", ev.Type)
+			assert.Equal(t, CGUEventReasonTimedout, ev.Reason)
+			assert.Equal(t, CGUEventActionClusterRemediationTimeout, ev.Action)
+			assert.Equal(t, tt.wantMsg, ev.Note)
+			assert.Equal(t, tt.wantAnnotations, ev.Annotations)
+			assert.Equal(t, "ManagedCluster", ev.Related.Kind)
+			assert.Equal(t, tt.clusterState.Name, ev.Related.Name)
+		})
+	}
+}
@@ -196,7 +196,10 @@ func (r *ClusterGroupUpgradeReconciler) createResourcesFromTemplates(
 		if err != nil {
 			return err
 		}
-		err = r.Create(ctx, obj)
+		createCtx, span := utils.StartSpan(ctx, "Create"+obj.GetKind(), utils.TraceAttributeCluster.String(data.Cluster),
+			utils.TraceAttributeResourceKind.String(obj.GetKind()), utils.TraceAttributeResourceName.String(obj.GetName()))
+		err = r.Create(createCtx, obj)
+		utils.EndSpan(span, client.IgnoreAlreadyExists(err))
 		if err != nil {
 			if errors.IsAlreadyExists(err) {
 				r.Log.Info("[createResourcesFromTemplates] Already exists",
@@ -212,7 +215,11 @@ func (r *ClusterGroupUpgradeReconciler) createResourcesFromTemplates(
 // deleteManagedClusterResource deletes resource by name and namespace
 // returns: error
 func (r *ClusterGroupUpgradeReconciler) deleteManagedClusterResource(
-	ctx context.Context, name string, namespace string, gvk schema.GroupVersionKind) error {
+	ctx context.Context, name string, namespace string, gvk schema.GroupVersionKind) (err error) {
+
+	ctx, span := utils.StartSpan(ctx, "Delete"+gvk.Kind, utils.TraceAttributeCluster.String(namespace),
+		utils.TraceAttributeResourceKind.String(gvk.Kind), utils.TraceAttributeResourceName.String(name))
+	defer func() { utils.EndSpan(span, err) }()
 
 	var obj = &unstructured.Unstructured{}
 	obj.SetGroupVersionKind(gvk)
@@ -231,11 +238,14 @@ func (r *ClusterGroupUpgradeReconciler) deleteManagedClusterResource(
 //	error
 func (r *ClusterGroupUpgradeReconciler) getView(
 	ctx context.Context, name string, namespace string) (
-	*unstructured.Unstructured, bool, error) {
+	view *unstructured.Unstructured, available bool, err error) {
+	ctx, span := utils.StartSpan(ctx, "GetManagedClusterView", utils.TraceAttributeCluster.String(namespace),
+		utils.TraceAttributeResourceKind.String(viewGroupVersionKind().Kind), utils.TraceAttributeResourceName.String(name))
+	defer func() { utils.EndSpan(span, err) }()
 
-	view := &unstructured.Unstructured{}
+	view = &unstructured.Unstructured{}
 	view.SetGroupVersionKind(viewGroupVersionKind())
-	err := r.Get(ctx, client.ObjectKey{
+	err = r.Get(ctx, client.ObjectKey{
 		Name:      name,
 		Namespace: namespace,
 	}, view)
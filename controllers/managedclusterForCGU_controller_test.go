@@ -24,6 +24,8 @@ import (
 	"github.com/go-logr/logr"
 	"github.com/openshift-kni/cluster-group-upgrades-operator/controllers/utils"
 	ranv1alpha1 "github.com/openshift-kni/cluster-group-upgrades-operator/pkg/api/clustergroupupgrades/v1alpha1"
+	actionv1beta1 "github.com/stolostron/cluster-lifecycle-api/action/v1beta1"
+	viewv1beta1 "github.com/stolostron/cluster-lifecycle-api/view/v1beta1"
 	"github.com/stretchr/testify/assert"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
@@ -45,12 +47,25 @@ var (
 
 func init() {
 	testscheme.AddKnownTypes(clusterv1.SchemeGroupVersion, &clusterv1.ManagedCluster{})
+	testscheme.AddKnownTypes(clusterv1.SchemeGroupVersion, &clusterv1.ManagedClusterList{})
 	testscheme.AddKnownTypes(ranv1alpha1.SchemeGroupVersion, &ranv1alpha1.ClusterGroupUpgrade{})
 	testscheme.AddKnownTypes(ranv1alpha1.SchemeGroupVersion, &ranv1alpha1.ClusterGroupUpgradeList{})
+	testscheme.AddKnownTypes(ranv1alpha1.SchemeGroupVersion, &ranv1alpha1.UpgradeConcurrencyPolicy{})
+	testscheme.AddKnownTypes(ranv1alpha1.SchemeGroupVersion, &ranv1alpha1.UpgradeConcurrencyPolicyList{})
+	testscheme.AddKnownTypes(ranv1alpha1.SchemeGroupVersion, &ranv1alpha1.UpgradeAlertsConfig{})
+	testscheme.AddKnownTypes(ranv1alpha1.SchemeGroupVersion, &ranv1alpha1.UpgradeAlertsConfigList{})
+	testscheme.AddKnownTypes(ranv1alpha1.SchemeGroupVersion, &ranv1alpha1.UpgradeNotificationEndpoint{})
+	testscheme.AddKnownTypes(ranv1alpha1.SchemeGroupVersion, &ranv1alpha1.UpgradeNotificationEndpointList{})
 	testscheme.AddKnownTypes(policiesv1.GroupVersion, &policiesv1.Policy{})
 	testscheme.AddKnownTypes(policiesv1.GroupVersion, &policiesv1.PolicyList{})
+	testscheme.AddKnownTypes(policiesv1.GroupVersion, &policiesv1.PlacementBinding{})
+	testscheme.AddKnownTypes(policiesv1.GroupVersion, &policiesv1.PlacementBindingList{})
 	testscheme.AddKnownTypes(clusterv1beta1.SchemeGroupVersion, &clusterv1beta1.Placement{})
 	testscheme.AddKnownTypes(clusterv1beta1.SchemeGroupVersion, &clusterv1beta1.PlacementList{})
+	testscheme.AddKnownTypes(viewv1beta1.GroupVersion, &viewv1beta1.ManagedClusterView{})
+	testscheme.AddKnownTypes(viewv1beta1.GroupVersion, &viewv1beta1.ManagedClusterViewList{})
+	testscheme.AddKnownTypes(actionv1beta1.GroupVersion, &actionv1beta1.ManagedClusterAction{})
+	testscheme.AddKnownTypes(actionv1beta1.GroupVersion, &actionv1beta1.ManagedClusterActionList{})
 }
 
 func getFakeClientFromObjects(objs ...client.Object) (client.WithWatch, error) {
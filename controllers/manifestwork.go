@@ -61,7 +61,7 @@ func (r *ClusterGroupUpgradeReconciler) getNextManifestWorkForCluster(
 func (r *ClusterGroupUpgradeReconciler) updateManifestWorkForCurrentBatch(
 	ctx context.Context, clusterGroupUpgrade *ranv1alpha1.ClusterGroupUpgrade) error {
 	for clusterName, clusterProgress := range clusterGroupUpgrade.Status.Status.CurrentBatchRemediationProgress {
-		if clusterProgress.State == ranv1alpha1.Completed {
+		if clusterProgress.State != ranv1alpha1.InProgress {
 			continue
 		}
 		currentIndex := *clusterProgress.ManifestWorkIndex
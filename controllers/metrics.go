@@ -0,0 +1,338 @@
+package controllers
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	utils "github.com/openshift-kni/cluster-group-upgrades-operator/controllers/utils"
+	ranv1alpha1 "github.com/openshift-kni/cluster-group-upgrades-operator/pkg/api/clustergroupupgrades/v1alpha1"
+	"github.com/prometheus/client_golang/prometheus"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const metricsNamespace = "talm"
+
+// Possible values of the result label of the duration metrics
+const (
+	metricsResultCompleted = "completed"
+	metricsResultTimedout  = "timedout"
+)
+
+// Possible values of the scope label of the timeouts metric
+const (
+	metricsScopeCGU     = "cgu"
+	metricsScopeBatch   = "batch"
+	metricsScopeCluster = "cluster"
+)
+
+// Possible values of the state label of the clusters metric besides the cluster final states
+const (
+	metricsClusterStatePending    = "pending"
+	metricsClusterStateNotStarted = "notstarted"
+	metricsClusterStateInProgress = "inprogress"
+)
+
+// The pre-caching states of the failed clusters, as reported by the pre-caching clusters metric
+var metricsPrecachingFailedStates = []string{strings.ToLower(PrecacheStateTimeout), strings.ToLower(PrecacheStateError)}
+
+// The remediation durations range from minutes to hours
+var durationBuckets = prometheus.ExponentialBuckets(60, 2, 10)
+
+var (
+	cguConditionGauge = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: metricsNamespace,
+		Name:      "cgu_condition",
+		Help:      "Conditions of the ClusterGroupUpgrades, set to 1 for the current status and reason of each condition type.",
+	}, []string{"namespace", "name", "type", "status", "reason"})
+	cguClustersGauge = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: metricsNamespace,
+		Name:      "cgu_clusters",
+		Help:      "Number of clusters of the ClusterGroupUpgrades by remediation state.",
+	}, []string{"namespace", "name", "state"})
+	cguPrecachingClustersGauge = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: metricsNamespace,
+		Name:      "cgu_precaching_clusters",
+		Help:      "Number of clusters of the ClusterGroupUpgrades by pre-caching state.",
+	}, []string{"namespace", "name", "state"})
+	cguBackupClustersGauge = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: metricsNamespace,
+		Name:      "cgu_backup_clusters",
+		Help:      "Number of clusters of the ClusterGroupUpgrades by backup state.",
+	}, []string{"namespace", "name", "state"})
+	cguStartTimeGauge = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: metricsNamespace,
+		Name:      "cgu_start_time_seconds",
+		Help:      "Start time of the remediation of the progressing ClusterGroupUpgrades, shifted by the time spent paused.",
+	}, []string{"namespace", "name"})
+	cguTimeoutGauge = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: metricsNamespace,
+		Name:      "cgu_timeout_seconds",
+		Help:      "Timeout of the progressing ClusterGroupUpgrades.",
+	}, []string{"namespace", "name"})
+	cguOldestPolicyStartTimeGauge = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: metricsNamespace,
+		Name:      "cgu_oldest_policy_start_time_seconds",
+		Help:      "Earliest time at which a cluster in progress of the ClusterGroupUpgrades moved on to its current managed policy.",
+	}, []string{"namespace", "name"})
+	batchDurationHistogram = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: metricsNamespace,
+		Name:      "batch_duration_seconds",
+		Help:      "Time taken by the batches from their start until they completed or timed out.",
+		Buckets:   durationBuckets,
+	}, []string{"result"})
+	clusterRemediationDurationHistogram = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: metricsNamespace,
+		Name:      "cluster_remediation_duration_seconds",
+		Help:      "Time taken by the clusters from the start of their remediation until they completed or timed out.",
+		Buckets:   durationBuckets,
+	}, []string{"result"})
+	policyTimeToComplianceHistogram = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: metricsNamespace,
+		Name:      "policy_time_to_compliance_seconds",
+		Help:      "Time taken by the clusters to become compliant with a managed policy once it is enforced.",
+		Buckets:   durationBuckets,
+	}, []string{"policy"})
+	timeoutsCounter = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: metricsNamespace,
+		Name:      "timeouts_total",
+		Help:      "Number of ClusterGroupUpgrades, batches and clusters that timed out.",
+	}, []string{"scope"})
+	canaryFailuresCounter = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: metricsNamespace,
+		Name:      "canary_failures_total",
+		Help:      "Number of ClusterGroupUpgrades stopped or rolled back because of a canary failure.",
+	})
+	cguTimeoutsCounter = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: metricsNamespace,
+		Name:      "cgu_timeouts_total",
+		Help:      "Number of times the ClusterGroupUpgrades, their batches and their clusters timed out.",
+	}, []string{"namespace", "name", "scope"})
+	cguCanaryFailuresCounter = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: metricsNamespace,
+		Name:      "cgu_canary_failures_total",
+		Help:      "Number of times the ClusterGroupUpgrades were stopped or rolled back because of a canary failure.",
+	}, []string{"namespace", "name"})
+)
+
+// RegisterMetrics registers the ClusterGroupUpgrade metrics in the given registry
+func RegisterMetrics(registry prometheus.Registerer) {
+	registry.MustRegister(
+		cguConditionGauge,
+		cguClustersGauge,
+		cguPrecachingClustersGauge,
+		cguBackupClustersGauge,
+		cguStartTimeGauge,
+		cguTimeoutGauge,
+		cguOldestPolicyStartTimeGauge,
+		batchDurationHistogram,
+		clusterRemediationDurationHistogram,
+		policyTimeToComplianceHistogram,
+		timeoutsCounter,
+		canaryFailuresCounter,
+		cguTimeoutsCounter,
+		cguCanaryFailuresCounter,
+	)
+}
+
+// updateCGUMetrics sets the gauges of the ClusterGroupUpgrade from its status
+func updateCGUMetrics(clusterGroupUpgrade *ranv1alpha1.ClusterGroupUpgrade) {
+	deleteCGUGauges(clusterGroupUpgrade.Namespace, clusterGroupUpgrade.Name)
+	labels := prometheus.Labels{"namespace": clusterGroupUpgrade.Namespace, "name": clusterGroupUpgrade.Name}
+
+	// The counters start at 0 so that their first increase is seen
+	for _, scope := range []string{metricsScopeCGU, metricsScopeBatch, metricsScopeCluster} {
+		cguTimeoutsCounter.WithLabelValues(clusterGroupUpgrade.Namespace, clusterGroupUpgrade.Name, scope)
+	}
+	cguCanaryFailuresCounter.WithLabelValues(clusterGroupUpgrade.Namespace, clusterGroupUpgrade.Name)
+
+	for _, condition := range clusterGroupUpgrade.Status.Conditions {
+		cguConditionGauge.With(withLabels(labels, prometheus.Labels{
+			"type": condition.Type, "status": string(condition.Status), "reason": condition.Reason})).Set(1)
+	}
+
+	clusterStates := make(map[string]int)
+	for _, clusterState := range clusterGroupUpgrade.Status.Clusters {
+		clusterStates[clusterState.State]++
+	}
+	for clusterName := range getPendingClusters(clusterGroupUpgrade) {
+		state := metricsClusterStatePending
+		if clusterProgress, ok := clusterGroupUpgrade.Status.Status.CurrentBatchRemediationProgress[clusterName]; ok && clusterProgress != nil {
+			switch clusterProgress.State {
+			case ranv1alpha1.NotStarted:
+				state = metricsClusterStateNotStarted
+			case ranv1alpha1.InProgress:
+				state = metricsClusterStateInProgress
+			}
+		}
+		clusterStates[state]++
+	}
+	setStateCounts(cguClustersGauge, labels, clusterStates)
+
+	if clusterGroupUpgrade.Status.Precaching != nil {
+		setStateCounts(cguPrecachingClustersGauge, labels, countStates(clusterGroupUpgrade.Status.Precaching.Status))
+	}
+	if clusterGroupUpgrade.Status.Backup != nil {
+		setStateCounts(cguBackupClustersGauge, labels, countStates(clusterGroupUpgrade.Status.Backup.Status))
+	}
+
+	if !clusterGroupUpgrade.Status.Status.StartedAt.IsZero() &&
+		meta.IsStatusConditionTrue(clusterGroupUpgrade.Status.Conditions, string(utils.ConditionTypes.Progressing)) {
+		cguStartTimeGauge.With(labels).Set(float64(clusterGroupUpgrade.Status.Status.StartedAt.Unix()))
+		if clusterGroupUpgrade.Spec.RemediationStrategy != nil {
+			cguTimeoutGauge.With(labels).Set(
+				(time.Duration(clusterGroupUpgrade.Spec.RemediationStrategy.Timeout) * time.Minute).Seconds())
+		}
+
+		var oldestPolicyStartedAt *metav1.Time
+		for _, clusterProgress := range clusterGroupUpgrade.Status.Status.CurrentBatchRemediationProgress {
+			if clusterProgress == nil || clusterProgress.State != ranv1alpha1.InProgress || clusterProgress.PolicyStartedAt == nil {
+				continue
+			}
+			if oldestPolicyStartedAt == nil || clusterProgress.PolicyStartedAt.Before(oldestPolicyStartedAt) {
+				oldestPolicyStartedAt = clusterProgress.PolicyStartedAt
+			}
+		}
+		if oldestPolicyStartedAt != nil {
+			cguOldestPolicyStartTimeGauge.With(labels).Set(float64(oldestPolicyStartedAt.Unix()))
+		}
+	}
+}
+
+// deleteCGUMetrics removes the gauges and the counters of a deleted ClusterGroupUpgrade
+func deleteCGUMetrics(namespace, name string) {
+	deleteCGUGauges(namespace, name)
+	labels := prometheus.Labels{"namespace": namespace, "name": name}
+	cguTimeoutsCounter.DeletePartialMatch(labels)
+	cguCanaryFailuresCounter.DeletePartialMatch(labels)
+}
+
+// deleteCGUGauges removes the gauges of a ClusterGroupUpgrade
+func deleteCGUGauges(namespace, name string) {
+	labels := prometheus.Labels{"namespace": namespace, "name": name}
+	for _, gauge := range []*prometheus.GaugeVec{
+		cguConditionGauge, cguClustersGauge, cguPrecachingClustersGauge, cguBackupClustersGauge,
+		cguStartTimeGauge, cguTimeoutGauge, cguOldestPolicyStartTimeGauge} {
+		gauge.DeletePartialMatch(labels)
+	}
+}
+
+func withLabels(labels, extraLabels prometheus.Labels) prometheus.Labels {
+	merged := prometheus.Labels{}
+	for k, v := range labels {
+		merged[k] = v
+	}
+	for k, v := range extraLabels {
+		merged[k] = v
+	}
+	return merged
+}
+
+// countStates returns the number of clusters in each state of a map of states by cluster
+func countStates(statesByCluster map[string]string) map[string]int {
+	states := make(map[string]int)
+	for _, state := range statesByCluster {
+		states[strings.ToLower(state)]++
+	}
+	return states
+}
+
+func setStateCounts(gauge *prometheus.GaugeVec, labels prometheus.Labels, states map[string]int) {
+	for state, count := range states {
+		gauge.With(withLabels(labels, prometheus.Labels{"state": state})).Set(float64(count))
+	}
+}
+
+// metricsObservations holds the observations made while reconciling a ClusterGroupUpgrade. They are only
+// recorded once the status holding the transitions they observe is written, so that a status update failing or
+// retried on conflict does not count them twice.
+type metricsObservations struct {
+	pending []func()
+}
+
+type metricsObservationsKey struct{}
+
+// withMetricsObservations returns a context holding the observations of the reconciliation
+func withMetricsObservations(ctx context.Context) context.Context {
+	return context.WithValue(ctx, metricsObservationsKey{}, &metricsObservations{})
+}
+
+// deferObservation holds the observation until the status is written. It is recorded right away when the
+// context does not hold the observations of a reconciliation.
+func deferObservation(ctx context.Context, observe func()) {
+	observations, ok := ctx.Value(metricsObservationsKey{}).(*metricsObservations)
+	if !ok {
+		observe()
+		return
+	}
+	observations.pending = append(observations.pending, observe)
+}
+
+// recordObservations records the observations held by the context, once the status has been written
+func recordObservations(ctx context.Context) {
+	observations, ok := ctx.Value(metricsObservationsKey{}).(*metricsObservations)
+	if !ok {
+		return
+	}
+	for _, observe := range observations.pending {
+		observe()
+	}
+	observations.pending = nil
+}
+
+// observeDurationSince records the time elapsed since the given start time, if it is set. The duration is
+// measured now even though it is recorded with the status.
+func observeDurationSince(ctx context.Context, observer prometheus.Observer, startedAt *metav1.Time) {
+	if startedAt == nil || startedAt.IsZero() {
+		return
+	}
+	duration := time.Since(startedAt.Time).Seconds()
+	deferObservation(ctx, func() { observer.Observe(duration) })
+}
+
+// observeBatchDone records the duration of the current batch once it completed or timed out
+func observeBatchDone(ctx context.Context, clusterGroupUpgrade *ranv1alpha1.ClusterGroupUpgrade, result string) {
+	observeDurationSince(ctx, batchDurationHistogram.WithLabelValues(result), &clusterGroupUpgrade.Status.Status.CurrentBatchStartedAt)
+	if result == metricsResultTimedout {
+		observeTimeout(ctx, clusterGroupUpgrade, metricsScopeBatch)
+	}
+}
+
+// observeClusterDone records the duration of the remediation of a cluster once it completed or timed out
+func observeClusterDone(ctx context.Context, clusterGroupUpgrade *ranv1alpha1.ClusterGroupUpgrade, clusterName, result string) {
+	if clusterProgress := clusterGroupUpgrade.Status.Status.CurrentBatchRemediationProgress[clusterName]; clusterProgress != nil {
+		observeDurationSince(ctx, clusterRemediationDurationHistogram.WithLabelValues(result), clusterProgress.StartedAt)
+	}
+	if result == metricsResultTimedout {
+		observeTimeout(ctx, clusterGroupUpgrade, metricsScopeCluster)
+	}
+}
+
+// observePolicyCompliant records the time taken by a cluster to become compliant with the policy it was at
+func observePolicyCompliant(ctx context.Context, clusterGroupUpgrade *ranv1alpha1.ClusterGroupUpgrade, clusterName string) {
+	clusterProgress := clusterGroupUpgrade.Status.Status.CurrentBatchRemediationProgress[clusterName]
+	if clusterGroupUpgrade.RolloutType() != ranv1alpha1.RolloutTypes.Policy || clusterProgress == nil ||
+		clusterProgress.PolicyIndex == nil || *clusterProgress.PolicyIndex >= len(clusterGroupUpgrade.Status.ManagedPoliciesForUpgrade) {
+		return
+	}
+	policyName := clusterGroupUpgrade.Status.ManagedPoliciesForUpgrade[*clusterProgress.PolicyIndex].Name
+	observeDurationSince(ctx, policyTimeToComplianceHistogram.WithLabelValues(policyName), clusterProgress.PolicyStartedAt)
+}
+
+// observeCGUTimedout counts a ClusterGroupUpgrade that timed out
+func observeCGUTimedout(ctx context.Context, clusterGroupUpgrade *ranv1alpha1.ClusterGroupUpgrade) {
+	observeTimeout(ctx, clusterGroupUpgrade, metricsScopeCGU)
+}
+
+// observeTimeout counts a timeout of the scope, in total and for the ClusterGroupUpgrade
+func observeTimeout(ctx context.Context, clusterGroupUpgrade *ranv1alpha1.ClusterGroupUpgrade, scope string) {
+	deferObservation(ctx, timeoutsCounter.WithLabelValues(scope).Inc)
+	deferObservation(ctx, cguTimeoutsCounter.WithLabelValues(clusterGroupUpgrade.Namespace, clusterGroupUpgrade.Name, scope).Inc)
+}
+
+// observeCanaryFailure counts a ClusterGroupUpgrade whose canaries failed
+func observeCanaryFailure(ctx context.Context, clusterGroupUpgrade *ranv1alpha1.ClusterGroupUpgrade) {
+	deferObservation(ctx, canaryFailuresCounter.Inc)
+	deferObservation(ctx, cguCanaryFailuresCounter.WithLabelValues(clusterGroupUpgrade.Namespace, clusterGroupUpgrade.Name).Inc)
+}
@@ -0,0 +1,208 @@
+package controllers
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/openshift-kni/cluster-group-upgrades-operator/controllers/utils"
+	"github.com/openshift-kni/cluster-group-upgrades-operator/pkg/api/clustergroupupgrades/v1alpha1"
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/api/errors"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+// collectMetrics returns the value of each metric of the collector by its label values joined with commas
+func collectMetrics(t *testing.T, collector prometheus.Collector) map[string]float64 {
+	ch := make(chan prometheus.Metric, 100)
+	collector.Collect(ch)
+	close(ch)
+	values := make(map[string]float64)
+	for metric := range ch {
+		m := &dto.Metric{}
+		if err := metric.Write(m); err != nil {
+			t.Fatalf("error writing metric: %v", err)
+		}
+		key := ""
+		for i, label := range m.GetLabel() {
+			if i > 0 {
+				key += ","
+			}
+			key += label.GetValue()
+		}
+		switch {
+		case m.Gauge != nil:
+			values[key] = m.Gauge.GetValue()
+		case m.Counter != nil:
+			values[key] = m.Counter.GetValue()
+		case m.Histogram != nil:
+			values[key] = float64(m.Histogram.GetSampleCount())
+		}
+	}
+	return values
+}
+
+func TestCGUMetrics(t *testing.T) {
+	policyIndex := 0
+	startedAt := v1.NewTime(time.Now().Add(-10 * time.Minute))
+	cgu := &v1alpha1.ClusterGroupUpgrade{
+		ObjectMeta: v1.ObjectMeta{Name: "cgu", Namespace: "ns"},
+		Spec:       v1alpha1.ClusterGroupUpgradeSpec{ManagedPolicies: []string{"policy1"}},
+		Status: v1alpha1.ClusterGroupUpgradeStatus{
+			Conditions: []v1.Condition{{Type: string(utils.ConditionTypes.Progressing), Status: v1.ConditionTrue,
+				Reason: string(utils.ConditionReasons.InProgress)}},
+			RemediationPlan:           [][]string{{"spoke1", "spoke2", "spoke3"}, {"spoke4"}},
+			ManagedPoliciesForUpgrade: []v1alpha1.ManagedPolicyForUpgrade{{Name: "policy1", Namespace: "default"}},
+			Clusters:                  []v1alpha1.ClusterState{{Name: "spoke1", State: utils.ClusterRemediationComplete}},
+			Status: v1alpha1.UpgradeStatus{
+				CurrentBatch:          1,
+				CurrentBatchStartedAt: startedAt,
+				CurrentBatchRemediationProgress: map[string]*v1alpha1.ClusterRemediationProgress{
+					"spoke2": {State: v1alpha1.InProgress, PolicyIndex: &policyIndex, StartedAt: &startedAt, PolicyStartedAt: &startedAt},
+					"spoke3": {State: v1alpha1.NotStarted},
+				},
+			},
+			Precaching: &v1alpha1.PrecachingStatus{Status: map[string]string{
+				"spoke1": "Succeeded", "spoke2": "Succeeded", "spoke3": "Failed"}},
+		},
+	}
+
+	updateCGUMetrics(cgu)
+	assert.Equal(t, map[string]float64{"cgu,ns,InProgress,True,Progressing": 1}, collectMetrics(t, cguConditionGauge))
+	assert.Equal(t, map[string]float64{
+		"cgu,ns,complete":   1,
+		"cgu,ns,inprogress": 1,
+		"cgu,ns,notstarted": 1,
+		"cgu,ns,pending":    1,
+	}, collectMetrics(t, cguClustersGauge))
+	assert.Equal(t, map[string]float64{"cgu,ns,succeeded": 2, "cgu,ns,failed": 1}, collectMetrics(t, cguPrecachingClustersGauge))
+	assert.Empty(t, collectMetrics(t, cguBackupClustersGauge))
+
+	// Not started yet
+	assert.Empty(t, collectMetrics(t, cguStartTimeGauge))
+
+	cgu.Spec.RemediationStrategy = &v1alpha1.RemediationStrategySpec{Timeout: 240}
+	cgu.Status.Status.StartedAt = startedAt
+	updateCGUMetrics(cgu)
+	assert.Equal(t, map[string]float64{"cgu,ns": float64(startedAt.Unix())}, collectMetrics(t, cguStartTimeGauge))
+	assert.Equal(t, map[string]float64{"cgu,ns": 240 * 60}, collectMetrics(t, cguTimeoutGauge))
+	assert.Equal(t, map[string]float64{"cgu,ns": float64(startedAt.Unix())}, collectMetrics(t, cguOldestPolicyStartTimeGauge))
+
+	// The series of the previous states are removed
+	cgu.Status.Conditions[0].Reason = string(utils.ConditionReasons.Completed)
+	cgu.Status.Clusters = append(cgu.Status.Clusters,
+		v1alpha1.ClusterState{Name: "spoke2", State: utils.ClusterRemediationComplete},
+		v1alpha1.ClusterState{Name: "spoke3", State: utils.ClusterRemediationTimedout},
+		v1alpha1.ClusterState{Name: "spoke4", State: utils.ClusterRemediationComplete})
+	cgu.Status.Conditions[0].Status = v1.ConditionFalse
+	updateCGUMetrics(cgu)
+	assert.Equal(t, map[string]float64{"cgu,ns,Completed,False,Progressing": 1}, collectMetrics(t, cguConditionGauge))
+	assert.Equal(t, map[string]float64{"cgu,ns,complete": 3, "cgu,ns,timedout": 1}, collectMetrics(t, cguClustersGauge))
+	assert.Empty(t, collectMetrics(t, cguStartTimeGauge))
+	assert.Empty(t, collectMetrics(t, cguOldestPolicyStartTimeGauge))
+
+	deleteCGUMetrics("ns", "cgu")
+	assert.Empty(t, collectMetrics(t, cguConditionGauge))
+	assert.Empty(t, collectMetrics(t, cguClustersGauge))
+	assert.Empty(t, collectMetrics(t, cguPrecachingClustersGauge))
+
+	batchCount := collectMetrics(t, batchDurationHistogram)["timedout"]
+	clusterCount := collectMetrics(t, clusterRemediationDurationHistogram)["timedout"]
+	policyCount := collectMetrics(t, policyTimeToComplianceHistogram)["policy1"]
+	timeouts := collectMetrics(t, timeoutsCounter)
+	canaryFailures := collectMetrics(t, canaryFailuresCounter)[""]
+	observeBatchDone(t.Context(), cgu, metricsResultTimedout)
+	observeClusterDone(t.Context(), cgu, "spoke2", metricsResultTimedout)
+	// spoke3 has not started, it is only counted as a timeout
+	observeClusterDone(t.Context(), cgu, "spoke3", metricsResultTimedout)
+	observePolicyCompliant(t.Context(), cgu, "spoke2")
+	observeCGUTimedout(t.Context(), cgu)
+	observeCanaryFailure(t.Context(), cgu)
+	assert.Equal(t, batchCount+1, collectMetrics(t, batchDurationHistogram)["timedout"])
+	assert.Equal(t, clusterCount+1, collectMetrics(t, clusterRemediationDurationHistogram)["timedout"])
+	assert.Equal(t, policyCount+1, collectMetrics(t, policyTimeToComplianceHistogram)["policy1"])
+	newTimeouts := collectMetrics(t, timeoutsCounter)
+	assert.Equal(t, timeouts["batch"]+1, newTimeouts["batch"])
+	assert.Equal(t, timeouts["cluster"]+2, newTimeouts["cluster"])
+	assert.Equal(t, timeouts["cgu"]+1, newTimeouts["cgu"])
+	assert.Equal(t, canaryFailures+1, collectMetrics(t, canaryFailuresCounter)[""])
+	// Other tests may have counted the timeouts of their own CGUs
+	cguCounters := func() map[string]float64 {
+		counters := make(map[string]float64)
+		for _, collector := range []prometheus.Collector{cguTimeoutsCounter, cguCanaryFailuresCounter} {
+			for key, value := range collectMetrics(t, collector) {
+				if strings.HasPrefix(key, "cgu,ns") {
+					counters[key] = value
+				}
+			}
+		}
+		return counters
+	}
+	counters := map[string]float64{"cgu,ns,batch": 1, "cgu,ns,cluster": 2, "cgu,ns,cgu": 1, "cgu,ns": 1}
+	assert.Equal(t, counters, cguCounters())
+
+	// The counters of a CGU are kept until it is deleted
+	updateCGUMetrics(cgu)
+	assert.Equal(t, counters, cguCounters())
+	deleteCGUMetrics("ns", "cgu")
+	assert.Empty(t, cguCounters())
+
+	// They start at 0
+	updateCGUMetrics(cgu)
+	assert.Equal(t, map[string]float64{"cgu,ns,batch": 0, "cgu,ns,cluster": 0, "cgu,ns,cgu": 0, "cgu,ns": 0}, cguCounters())
+	deleteCGUMetrics("ns", "cgu")
+}
+
+func TestCGUMetricsObservedOnStatusUpdate(t *testing.T) {
+	cgu := &v1alpha1.ClusterGroupUpgrade{ObjectMeta: v1.ObjectMeta{Name: "cgu", Namespace: "ns"}}
+	reconciler := &ClusterGroupUpgradeReconciler{Client: fake.NewClientBuilder().WithScheme(testscheme).
+		WithStatusSubresource(&v1alpha1.ClusterGroupUpgrade{}).Build()}
+	ctx := withMetricsObservations(t.Context())
+	timeouts := collectMetrics(t, timeoutsCounter)["cgu"]
+
+	// Nothing is recorded until the status is written
+	observeCGUTimedout(ctx, cgu)
+	assert.Equal(t, timeouts, collectMetrics(t, timeoutsCounter)["cgu"])
+	assert.Error(t, reconciler.updateStatus(ctx, cgu))
+	assert.Equal(t, timeouts, collectMetrics(t, timeoutsCounter)["cgu"])
+
+	assert.NoError(t, reconciler.Create(ctx, cgu))
+	assert.NoError(t, reconciler.updateStatus(ctx, cgu))
+	assert.Equal(t, timeouts+1, collectMetrics(t, timeoutsCounter)["cgu"])
+
+	// The observations are only recorded once
+	assert.NoError(t, reconciler.updateStatus(ctx, cgu))
+	assert.Equal(t, timeouts+1, collectMetrics(t, timeoutsCounter)["cgu"])
+	deleteCGUMetrics("ns", "cgu")
+}
+
+func TestCGUMetricsDeletedCGU(t *testing.T) {
+	enable := true
+	cgu := &v1alpha1.ClusterGroupUpgrade{
+		ObjectMeta: v1.ObjectMeta{Name: "deleted-cgu", Namespace: "ns", Finalizers: []string{utils.CleanupFinalizer}},
+		Spec:       v1alpha1.ClusterGroupUpgradeSpec{Enable: &enable},
+		Status: v1alpha1.ClusterGroupUpgradeStatus{
+			Conditions: []v1.Condition{{Type: string(utils.ConditionTypes.Succeeded), Status: v1.ConditionTrue,
+				Reason: string(utils.ConditionReasons.Completed)}},
+			Status: v1alpha1.UpgradeStatus{CompletedAt: v1.Now()},
+		},
+	}
+	fakeClient, err := getFakeClientFromObjects(cgu)
+	assert.NoError(t, err)
+	reconciler := &ClusterGroupUpgradeReconciler{Client: fakeClient, Log: logr.Discard(), Scheme: testscheme}
+	updateCGUMetrics(cgu)
+	assert.Contains(t, collectMetrics(t, cguConditionGauge), "deleted-cgu,ns,Completed,True,Succeeded")
+
+	// The finalizer removes the gauges, the deleted CGU is not reconciled again
+	assert.NoError(t, fakeClient.Delete(t.Context(), cgu))
+	assert.NoError(t, fakeClient.Get(t.Context(), types.NamespacedName{Name: "deleted-cgu", Namespace: "ns"}, cgu))
+	_, err = reconciler.handleCguFinalizer(t.Context(), cgu)
+	assert.NoError(t, err)
+	assert.NotContains(t, collectMetrics(t, cguConditionGauge), "deleted-cgu,ns,Completed,True,Succeeded")
+	assert.True(t, errors.IsNotFound(fakeClient.Get(t.Context(), types.NamespacedName{Name: "deleted-cgu", Namespace: "ns"}, cgu)))
+}
@@ -0,0 +1,331 @@
+package controllers
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/google/uuid"
+	ranv1alpha1 "github.com/openshift-kni/cluster-group-upgrades-operator/pkg/api/clustergroupupgrades/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// NotificationSignatureHeader is the header of the HMAC-SHA256 signature of the body, hex encoded and
+	// prefixed by sha256=
+	NotificationSignatureHeader = "X-Cgu-Signature-256"
+	// NotificationCloudEventTypePrefix prefixes the event action in the type of the CloudEvents
+	NotificationCloudEventTypePrefix = "io.openshift.ran.clustergroupupgrade."
+	// NotificationCloudEventSource is the source of the CloudEvents
+	NotificationCloudEventSource = "/cluster-group-upgrades-operator"
+
+	notificationCloudEventsContentType = "application/cloudevents+json"
+	notificationJSONContentType        = "application/json"
+	notificationTimeout                = 10 * time.Second
+	notificationRetryInterval          = time.Second
+	notificationQueueSize              = 1000
+	notificationWorkers                = 4
+	notificationDefaultSigningKey      = "key"
+)
+
+// Notification is the body of the JSON notifications and the data of the CloudEvents
+type Notification struct {
+	Time        metav1.Time             `json:"time"`
+	Kind        string                  `json:"kind"`
+	Namespace   string                  `json:"namespace"`
+	Name        string                  `json:"name"`
+	Type        string                  `json:"type"`
+	Reason      string                  `json:"reason"`
+	Action      string                  `json:"action"`
+	Note        string                  `json:"note"`
+	Annotations map[string]string       `json:"annotations,omitempty"`
+	Related     *corev1.ObjectReference `json:"related,omitempty"`
+}
+
+// cloudEvent is a CloudEvent in structured content mode, as defined by the CloudEvents 1.0 specification
+type cloudEvent struct {
+	SpecVersion     string        `json:"specversion"`
+	ID              string        `json:"id"`
+	Source          string        `json:"source"`
+	Type            string        `json:"type"`
+	Subject         string        `json:"subject"`
+	Time            string        `json:"time"`
+	DataContentType string        `json:"datacontenttype"`
+	Data            *Notification `json:"data"`
+}
+
+// notificationDelivery is a notification waiting to be posted to an endpoint
+type notificationDelivery struct {
+	endpoint    string
+	url         string
+	contentType string
+	signature   string
+	body        []byte
+	maxRetries  int
+	reason      string
+	action      string
+}
+
+// Notifier sends the events of the ClusterGroupUpgrades to the UpgradeNotificationEndpoints. It is a Runnable of the
+// manager, whose workers post the queued notifications.
+type Notifier struct {
+	client client.Reader
+	log    logr.Logger
+	// Namespace of the signing Secrets, the one of the operator
+	namespace     string
+	httpClient    *http.Client
+	retryInterval time.Duration
+	deliveries    chan *notificationDelivery
+	pending       sync.WaitGroup
+	// mutex protects stopping, set once no more notifications are queued
+	mutex    sync.Mutex
+	stopping bool
+}
+
+// NewNotifier creates a Notifier reading the UpgradeNotificationEndpoints and the signing Secrets with the client
+func NewNotifier(c client.Reader, log logr.Logger, namespace string) *Notifier {
+	return &Notifier{
+		client:        c,
+		log:           log,
+		namespace:     namespace,
+		httpClient:    &http.Client{Timeout: notificationTimeout},
+		retryInterval: notificationRetryInterval,
+		deliveries:    make(chan *notificationDelivery, notificationQueueSize),
+	}
+}
+
+// Start runs the workers posting the notifications until the context is done. The notifications are no longer
+// queued from then on, and the ones still queued are posted once, without retrying, before it returns.
+func (n *Notifier) Start(ctx context.Context) error {
+	var workers sync.WaitGroup
+	for range notificationWorkers {
+		workers.Go(func() {
+			for delivery := range n.deliveries {
+				if err := n.send(ctx, delivery); err != nil {
+					n.log.Error(err, "failed to send the notification", "endpoint", delivery.endpoint,
+						"reason", delivery.reason, "action", delivery.action)
+				}
+				n.pending.Done()
+			}
+		})
+	}
+	<-ctx.Done()
+
+	n.mutex.Lock()
+	n.stopping = true
+	close(n.deliveries)
+	n.mutex.Unlock()
+	n.log.Info("Waiting for the queued notifications to be sent")
+	workers.Wait()
+	return nil
+}
+
+// NeedLeaderElection returns false, the notifications of the reconciliations are sent even if the leader changes
+func (n *Notifier) NeedLeaderElection() bool {
+	return false
+}
+
+//+kubebuilder:rbac:groups=ran.openshift.io,resources=upgradenotificationendpoints,verbs=get;list;watch
+
+// Notify sends the notification to each UpgradeNotificationEndpoint whose reasons and actions match it.
+// The notifications are queued and posted by the workers so that slow or unreachable endpoints do not hold the
+// reconciliation, hence their order is not guaranteed. Like the events, they are best-effort: they are dropped
+// when the queue is full and failures are only logged.
+func (n *Notifier) Notify(ctx context.Context, notification *Notification) {
+	if n == nil {
+		return
+	}
+
+	endpoints := &ranv1alpha1.UpgradeNotificationEndpointList{}
+	if err := n.client.List(ctx, endpoints); err != nil {
+		if !meta.IsNoMatchError(err) {
+			n.log.Error(err, "failed to list the UpgradeNotificationEndpoints")
+		}
+		return
+	}
+
+	for i := range endpoints.Items {
+		endpoint := &endpoints.Items[i]
+		if !notificationMatches(&endpoint.Spec, notification) {
+			continue
+		}
+
+		body, contentType, err := buildNotificationBody(endpoint.Spec.Format, notification)
+		if err != nil {
+			n.log.Error(err, "failed to build the notification", "endpoint", endpoint.Name)
+			continue
+		}
+		signature := ""
+		if endpoint.Spec.SigningSecret != nil {
+			key, err := n.signingKey(ctx, endpoint.Spec.SigningSecret)
+			if err != nil {
+				n.log.Error(err, "failed to get the signing key of the notification", "endpoint", endpoint.Name)
+				continue
+			}
+			signature = signNotification(key, body)
+		}
+
+		if err := n.queue(&notificationDelivery{
+			endpoint:    endpoint.Name,
+			url:         endpoint.Spec.URL,
+			contentType: contentType,
+			signature:   signature,
+			body:        body,
+			maxRetries:  endpoint.Spec.MaxRetries,
+			reason:      notification.Reason,
+			action:      notification.Action,
+		}); err != nil {
+			n.log.Error(err, "failed to queue the notification",
+				"endpoint", endpoint.Name, "reason", notification.Reason, "action", notification.Action)
+		}
+	}
+}
+
+// queue queues the notification for the workers, unless the queue is full or the Notifier is stopping
+func (n *Notifier) queue(delivery *notificationDelivery) error {
+	n.mutex.Lock()
+	defer n.mutex.Unlock()
+	if n.stopping {
+		return errors.New("the notifier is stopping")
+	}
+	n.pending.Add(1)
+	select {
+	case n.deliveries <- delivery:
+		return nil
+	default:
+		n.pending.Done()
+		return errors.New("the notification queue is full")
+	}
+}
+
+// Wait blocks until the queued notifications are delivered or given up
+func (n *Notifier) Wait() {
+	if n == nil {
+		return
+	}
+	n.pending.Wait()
+}
+
+// send posts the notification, retrying with an exponential backoff when the endpoint is unreachable or
+// answers with a 429 or 5xx status code. Once the context is done, the notification is no longer retried.
+func (n *Notifier) send(ctx context.Context, delivery *notificationDelivery) error {
+	for attempt := 0; ; attempt++ {
+		// Each attempt is bounded by the timeout of the HTTP client, even when shutting down
+		retriable, err := n.post(context.WithoutCancel(ctx), delivery.url, delivery.contentType, delivery.signature, delivery.body)
+		if err == nil {
+			return nil
+		}
+		if !retriable || attempt >= delivery.maxRetries {
+			return fmt.Errorf("giving up after %d attempts: %w", attempt+1, err)
+		}
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("giving up after %d attempts, shutting down: %w", attempt+1, err)
+		case <-time.After(n.retryInterval << attempt):
+		}
+	}
+}
+
+// post posts the body once
+// returns: bool, whether the failure can be retried
+//
+//	error
+func (n *Notifier) post(ctx context.Context, url, contentType, signature string, body []byte) (bool, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return false, err
+	}
+	req.Header.Set("Content-Type", contentType)
+	if signature != "" {
+		req.Header.Set(NotificationSignatureHeader, signature)
+	}
+
+	resp, err := n.httpClient.Do(req)
+	if err != nil {
+		return true, err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	switch {
+	case resp.StatusCode >= 200 && resp.StatusCode < 300:
+		return false, nil
+	case resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= 500:
+		return true, fmt.Errorf("unexpected status code %d", resp.StatusCode)
+	default:
+		return false, fmt.Errorf("unexpected status code %d", resp.StatusCode)
+	}
+}
+
+// signingKey returns the HMAC key of the signing Secret
+func (n *Notifier) signingKey(ctx context.Context, secretRef *ranv1alpha1.NotificationSigningSecret) ([]byte, error) {
+	if n.namespace == "" {
+		return nil, errors.New("POD_NAMESPACE is not set, the signing Secret cannot be found")
+	}
+	secret := &corev1.Secret{}
+	if err := n.client.Get(ctx, types.NamespacedName{Name: secretRef.Name, Namespace: n.namespace}, secret); err != nil {
+		return nil, err
+	}
+	keyName := secretRef.Key
+	if keyName == "" {
+		keyName = notificationDefaultSigningKey
+	}
+	key, ok := secret.Data[keyName]
+	if !ok || len(key) == 0 {
+		return nil, fmt.Errorf("key %s not found in Secret %s/%s", keyName, n.namespace, secretRef.Name)
+	}
+	return key, nil
+}
+
+// notificationMatches returns whether the notification is sent to the endpoint
+func notificationMatches(spec *ranv1alpha1.UpgradeNotificationEndpointSpec, notification *Notification) bool {
+	if len(spec.Reasons) > 0 && !slices.Contains(spec.Reasons, notification.Reason) {
+		return false
+	}
+	if len(spec.Actions) > 0 && !slices.Contains(spec.Actions, notification.Action) {
+		return false
+	}
+	return true
+}
+
+// buildNotificationBody returns the body of the notification in the format and its content type
+func buildNotificationBody(format ranv1alpha1.NotificationFormat, notification *Notification) ([]byte, string, error) {
+	if format == ranv1alpha1.NotificationFormatJSON {
+		body, err := json.Marshal(notification)
+		return body, notificationJSONContentType, err
+	}
+
+	body, err := json.Marshal(&cloudEvent{
+		SpecVersion:     "1.0",
+		ID:              uuid.NewString(),
+		Source:          NotificationCloudEventSource,
+		Type:            NotificationCloudEventTypePrefix + notification.Action,
+		Subject:         notification.Namespace + "/" + notification.Name,
+		Time:            notification.Time.UTC().Format(time.RFC3339Nano),
+		DataContentType: notificationJSONContentType,
+		Data:            notification,
+	})
+	return body, notificationCloudEventsContentType, err
+}
+
+// signNotification returns the value of the signature header of the body
+func signNotification(key, body []byte) string {
+	mac := hmac.New(sha256.New, key)
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
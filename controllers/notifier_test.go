@@ -0,0 +1,280 @@
+package controllers
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	ranv1alpha1 "github.com/openshift-kni/cluster-group-upgrades-operator/pkg/api/clustergroupupgrades/v1alpha1"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	eventsv1 "k8s.io/api/events/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+// notificationReceiver records the notifications posted to it, answering with the given status codes first
+type notificationReceiver struct {
+	mutex    sync.Mutex
+	statuses []int
+	requests []*http.Request
+	bodies   [][]byte
+}
+
+func (r *notificationReceiver) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	body, _ := io.ReadAll(req.Body)
+	r.requests = append(r.requests, req)
+	r.bodies = append(r.bodies, body)
+	if len(r.statuses) > 0 {
+		w.WriteHeader(r.statuses[0])
+		r.statuses = r.statuses[1:]
+		return
+	}
+	w.WriteHeader(http.StatusAccepted)
+}
+
+func TestNotifier(t *testing.T) {
+	cloudEventsReceiver := &notificationReceiver{}
+	cloudEventsServer := httptest.NewServer(cloudEventsReceiver)
+	defer cloudEventsServer.Close()
+	// Retried twice before succeeding
+	jsonReceiver := &notificationReceiver{statuses: []int{http.StatusServiceUnavailable, http.StatusTooManyRequests}}
+	jsonServer := httptest.NewServer(jsonReceiver)
+	defer jsonServer.Close()
+	// Not retried
+	rejectingReceiver := &notificationReceiver{statuses: []int{http.StatusBadRequest}}
+	rejectingServer := httptest.NewServer(rejectingReceiver)
+	defer rejectingServer.Close()
+	filteredReceiver := &notificationReceiver{}
+	filteredServer := httptest.NewServer(filteredReceiver)
+	defer filteredServer.Close()
+
+	s := runtime.NewScheme()
+	_ = ranv1alpha1.AddToScheme(s)
+	_ = eventsv1.AddToScheme(s)
+	_ = corev1.AddToScheme(s)
+	fakeClient := fake.NewClientBuilder().WithScheme(s).WithObjects(
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "hmac", Namespace: "talm"},
+			Data:       map[string][]byte{"key": []byte("secret")},
+		},
+		&ranv1alpha1.UpgradeNotificationEndpoint{
+			ObjectMeta: metav1.ObjectMeta{Name: "cloudevents"},
+			Spec: ranv1alpha1.UpgradeNotificationEndpointSpec{
+				URL:           cloudEventsServer.URL,
+				Format:        ranv1alpha1.NotificationFormatCloudEvents,
+				SigningSecret: &ranv1alpha1.NotificationSigningSecret{Name: "hmac", Key: "key"},
+			},
+		},
+		&ranv1alpha1.UpgradeNotificationEndpoint{
+			ObjectMeta: metav1.ObjectMeta{Name: "json"},
+			Spec: ranv1alpha1.UpgradeNotificationEndpointSpec{
+				URL:        jsonServer.URL,
+				Format:     ranv1alpha1.NotificationFormatJSON,
+				Reasons:    []string{CGUEventReasonStarted},
+				MaxRetries: 3,
+			},
+		},
+		&ranv1alpha1.UpgradeNotificationEndpoint{
+			ObjectMeta: metav1.ObjectMeta{Name: "rejecting"},
+			Spec:       ranv1alpha1.UpgradeNotificationEndpointSpec{URL: rejectingServer.URL, MaxRetries: 3},
+		},
+		&ranv1alpha1.UpgradeNotificationEndpoint{
+			ObjectMeta: metav1.ObjectMeta{Name: "filtered"},
+			Spec: ranv1alpha1.UpgradeNotificationEndpointSpec{
+				URL:     filteredServer.URL,
+				Actions: []string{CGUEventActionCompleteBatchRemediation},
+			},
+		},
+	).Build()
+
+	notifier := NewNotifier(fakeClient, logr.Discard(), "talm")
+	notifier.retryInterval = 0
+	ctx, cancel := context.WithCancel(t.Context())
+	defer cancel()
+	go func() { _ = notifier.Start(ctx) }()
+	reconciler := &ClusterGroupUpgradeReconciler{
+		Client:       fakeClient,
+		Log:          logr.Discard(),
+		Scheme:       s,
+		EventEmitter: NewEmitter(fakeClient, s, "ClusterGroupUpgrade").WithNotifier(notifier),
+	}
+	cgu := newTestCGU()
+	cgu.Status.RemediationPlan = [][]string{{"spoke1", "spoke2"}}
+	reconciler.sendEventCGUStarted(t.Context(), cgu)
+	notifier.Wait()
+
+	// The Event is still created
+	events := &eventsv1.EventList{}
+	assert.NoError(t, fakeClient.List(t.Context(), events))
+	assert.Len(t, events.Items, 1)
+
+	assert.Len(t, cloudEventsReceiver.requests, 1)
+	request := cloudEventsReceiver.requests[0]
+	body := cloudEventsReceiver.bodies[0]
+	assert.Equal(t, "application/cloudevents+json", request.Header.Get("Content-Type"))
+	assert.Equal(t, signNotification([]byte("secret"), body), request.Header.Get(NotificationSignatureHeader))
+	ce := &cloudEvent{}
+	assert.NoError(t, json.Unmarshal(body, ce))
+	assert.Equal(t, "1.0", ce.SpecVersion)
+	assert.NotEmpty(t, ce.ID)
+	assert.Equal(t, NotificationCloudEventSource, ce.Source)
+	assert.Equal(t, NotificationCloudEventTypePrefix+CGUEventActionStartRemediation, ce.Type)
+	assert.Equal(t, "default/test-cgu", ce.Subject)
+	assert.Equal(t, CGUEventReasonStarted, ce.Data.Reason)
+	assert.Equal(t, "ClusterGroupUpgrade", ce.Data.Kind)
+	assert.Equal(t, "2", ce.Data.Annotations[CGUEventAnnotationKeyTotalClustersCount])
+
+	assert.Len(t, jsonReceiver.requests, 3)
+	assert.Equal(t, "application/json", jsonReceiver.requests[2].Header.Get("Content-Type"))
+	assert.Empty(t, jsonReceiver.requests[2].Header.Get(NotificationSignatureHeader))
+	notification := &Notification{}
+	assert.NoError(t, json.Unmarshal(jsonReceiver.bodies[2], notification))
+	assert.Equal(t, CGUEventActionStartRemediation, notification.Action)
+	assert.Equal(t, "test-cgu", notification.Name)
+	assert.Equal(t, "default", notification.Namespace)
+	assert.Equal(t, corev1.EventTypeNormal, notification.Type)
+
+	assert.Len(t, rejectingReceiver.requests, 1)
+	assert.Empty(t, filteredReceiver.requests)
+
+	// Only the endpoints matching the reason receive the notification
+	reconciler.sendEventCGUPaused(t.Context(), cgu)
+	notifier.Wait()
+	assert.Len(t, cloudEventsReceiver.requests, 2)
+	assert.Len(t, jsonReceiver.requests, 3)
+	assert.Len(t, rejectingReceiver.requests, 2)
+	assert.Empty(t, filteredReceiver.requests)
+}
+
+func TestNotifierMissingSigningSecret(t *testing.T) {
+	receiver := &notificationReceiver{}
+	server := httptest.NewServer(receiver)
+	defer server.Close()
+
+	s := runtime.NewScheme()
+	_ = ranv1alpha1.AddToScheme(s)
+	_ = corev1.AddToScheme(s)
+	fakeClient := fake.NewClientBuilder().WithScheme(s).WithObjects(
+		&ranv1alpha1.UpgradeNotificationEndpoint{
+			ObjectMeta: metav1.ObjectMeta{Name: "signed"},
+			Spec: ranv1alpha1.UpgradeNotificationEndpointSpec{
+				URL:           server.URL,
+				SigningSecret: &ranv1alpha1.NotificationSigningSecret{Name: "missing"},
+			},
+		},
+	).Build()
+
+	// Unsigned notifications are never sent to an endpoint expecting a signature
+	notifier := NewNotifier(fakeClient, logr.Discard(), "talm")
+	notifier.Notify(t.Context(), &Notification{Reason: CGUEventReasonStarted})
+	notifier.Wait()
+	assert.Empty(t, receiver.requests)
+
+	// A nil Notifier does nothing
+	var nilNotifier *Notifier
+	nilNotifier.Notify(t.Context(), &Notification{Reason: CGUEventReasonStarted})
+	nilNotifier.Wait()
+}
+
+func TestNotifierShutdown(t *testing.T) {
+	receiver := &notificationReceiver{statuses: []int{http.StatusServiceUnavailable}}
+	server := httptest.NewServer(receiver)
+	defer server.Close()
+
+	s := runtime.NewScheme()
+	_ = ranv1alpha1.AddToScheme(s)
+	fakeClient := fake.NewClientBuilder().WithScheme(s).WithObjects(
+		&ranv1alpha1.UpgradeNotificationEndpoint{
+			ObjectMeta: metav1.ObjectMeta{Name: "unavailable"},
+			Spec:       ranv1alpha1.UpgradeNotificationEndpointSpec{URL: server.URL, MaxRetries: 3},
+		},
+	).Build()
+
+	// The notifications are dropped once the queue is full
+	notifier := NewNotifier(fakeClient, logr.Discard(), "talm")
+	notifier.deliveries = make(chan *notificationDelivery)
+	notifier.Notify(t.Context(), &Notification{Reason: CGUEventReasonStarted})
+	notifier.Wait()
+	assert.Empty(t, receiver.requests)
+
+	// The backoff stops on shutdown, once the notifications are posted
+	notifier = NewNotifier(fakeClient, logr.Discard(), "talm")
+	notifier.retryInterval = time.Hour
+	ctx, cancel := context.WithCancel(t.Context())
+	stopped := make(chan struct{})
+	go func() {
+		_ = notifier.Start(ctx)
+		close(stopped)
+	}()
+	notifier.Notify(t.Context(), &Notification{Reason: CGUEventReasonStarted})
+	assert.Eventually(t, func() bool {
+		receiver.mutex.Lock()
+		defer receiver.mutex.Unlock()
+		return len(receiver.requests) == 1
+	}, 10*time.Second, 10*time.Millisecond)
+	cancel()
+	select {
+	case <-stopped:
+	case <-time.After(10 * time.Second):
+		t.Fatal("the notifier did not stop")
+	}
+	assert.Len(t, receiver.requests, 1)
+}
+
+func TestNotifierNotifyOnShutdown(t *testing.T) {
+	receiver := &notificationReceiver{}
+	server := httptest.NewServer(receiver)
+	defer server.Close()
+
+	s := runtime.NewScheme()
+	_ = ranv1alpha1.AddToScheme(s)
+	fakeClient := fake.NewClientBuilder().WithScheme(s).WithObjects(
+		&ranv1alpha1.UpgradeNotificationEndpoint{
+			ObjectMeta: metav1.ObjectMeta{Name: "endpoint"},
+			Spec:       ranv1alpha1.UpgradeNotificationEndpointSpec{URL: server.URL},
+		},
+	).Build()
+
+	notifier := NewNotifier(fakeClient, logr.Discard(), "talm")
+	ctx, cancel := context.WithCancel(t.Context())
+	stopped := make(chan struct{})
+	go func() {
+		_ = notifier.Start(ctx)
+		close(stopped)
+	}()
+
+	// The reconcilers may still notify while the notifier stops
+	var notifying sync.WaitGroup
+	for range 10 {
+		notifying.Go(func() {
+			for range 10 {
+				notifier.Notify(t.Context(), &Notification{Reason: CGUEventReasonStarted})
+			}
+		})
+	}
+	cancel()
+	notifying.Wait()
+	select {
+	case <-stopped:
+	case <-time.After(10 * time.Second):
+		t.Fatal("the notifier did not stop")
+	}
+
+	// Once stopped, the notifications are dropped
+	receiver.mutex.Lock()
+	posted := len(receiver.requests)
+	receiver.mutex.Unlock()
+	notifier.Notify(t.Context(), &Notification{Reason: CGUEventReasonStarted})
+	notifier.Wait()
+	assert.Len(t, receiver.requests, posted)
+}
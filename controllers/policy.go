@@ -22,7 +22,9 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 )
 
-func (r *ClusterGroupUpgradeReconciler) updatePlacements(ctx context.Context, clusterGroupUpgrade *ranv1alpha1.ClusterGroupUpgrade) error {
+func (r *ClusterGroupUpgradeReconciler) updatePlacements(ctx context.Context, clusterGroupUpgrade *ranv1alpha1.ClusterGroupUpgrade) (err error) {
+	ctx, span := utils.StartCGUSpan(ctx, "updatePlacements", clusterGroupUpgrade)
+	defer func() { utils.EndSpan(span, err) }()
 
 	policiesToUpdate := make(map[int][]string)
 	for clusterName, clusterProgress := range clusterGroupUpgrade.Status.Status.CurrentBatchRemediationProgress {
@@ -126,6 +128,44 @@ func (r *ClusterGroupUpgradeReconciler) cleanupPlacements(ctx context.Context, c
 	return nil
 }
 
+// removeClusterFromPlacements stops enforcing the managed policies on a cluster
+func (r *ClusterGroupUpgradeReconciler) removeClusterFromPlacements(
+	ctx context.Context, clusterGroupUpgrade *ranv1alpha1.ClusterGroupUpgrade, clusterName string) error {
+
+	var targetNamespaces []string
+	for _, policy := range clusterGroupUpgrade.Status.ManagedPoliciesForUpgrade {
+		if _, ok := utils.FindStringInSlice(targetNamespaces, policy.Namespace); !ok {
+			targetNamespaces = append(targetNamespaces, policy.Namespace)
+		}
+	}
+
+	for _, ns := range targetNamespaces {
+		placements, err := r.getPlacements(ctx, clusterGroupUpgrade, nil, ns)
+		if err != nil {
+			return err
+		}
+
+		for i := range placements.Items {
+			existingNames, err := utils.GetPlacementClusterNames(&placements.Items[i])
+			if err != nil {
+				return err
+			}
+			index, found := utils.FindStringInSlice(existingNames, clusterName)
+			if !found {
+				continue
+			}
+			updatedNames := append(existingNames[:index:index], existingNames[index+1:]...)
+			if err := utils.SetPlacementClusterNames(&placements.Items[i], updatedNames); err != nil {
+				return err
+			}
+			if err := r.Update(ctx, &placements.Items[i]); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
 func (r *ClusterGroupUpgradeReconciler) getPolicyByName(ctx context.Context, policyName, namespace string) (*unstructured.Unstructured, error) {
 	foundPolicy := &unstructured.Unstructured{}
 	foundPolicy.SetGroupVersionKind(schema.GroupVersionKind{
@@ -157,14 +197,15 @@ func updateDuplicatedManagedPoliciesInfo(managedPoliciesInfo *policiesInfo, poli
 */
 func (r *ClusterGroupUpgradeReconciler) doManagedPoliciesExist(
 	ctx context.Context, clusterGroupUpgrade *ranv1alpha1.ClusterGroupUpgrade,
-	clusters []string) (bool, policiesInfo, error) {
+	clusters []string) (allPoliciesExist bool, managedPoliciesInfo policiesInfo, err error) {
+	ctx, span := utils.StartCGUSpan(ctx, "doManagedPoliciesExist", clusterGroupUpgrade)
+	defer func() { utils.EndSpan(span, err) }()
 
 	childPoliciesList, err := utils.GetChildPolicies(ctx, r.Client, clusters)
 	if err != nil {
 		return false, policiesInfo{}, err
 	}
 
-	var managedPoliciesInfo policiesInfo
 	// Go through all the child policies and split the namespace from the policy name.
 	// A child policy name has the name format parent_policy_namespace.parent_policy_name
 	// The policy map we are creating will be of format {"policy_name": "policy_namespace"}
@@ -249,7 +290,12 @@ func (r *ClusterGroupUpgradeReconciler) doManagedPoliciesExist(
 				continue
 			}
 
-			if !containsStatus {
+			// The CGU can override whether a policy all the clusters are compliant with is skipped
+			skipIfCompliant := !containsStatus
+			if override := utils.GetPolicyOverride(clusterGroupUpgrade, managedPolicyName); override != nil && override.SkipIfCompliant != nil {
+				skipIfCompliant = *override.SkipIfCompliant
+			}
+			if skipIfCompliant {
 				// Check the policy has at least one of the clusters from the CR in NonCompliant state.
 				clustersNonCompliantWithPolicy := r.getClustersNonCompliantWithPolicy(clusters, foundPolicy)
 
@@ -428,10 +474,17 @@ func (r *ClusterGroupUpgradeReconciler) getNextNonCompliantPolicyForCluster(
 			if !clusterInBatch {
 				continue
 			}
-			soakResult, err := shouldSoak(currentManagedPolicy, clusterGroupUpgrade.Status.Status.CurrentBatchRemediationProgress[clusterName].FirstCompliantAt)
-			if err != nil {
-				r.Log.Info(err.Error())
-				continue
+			firstCompliantAt := clusterGroupUpgrade.Status.Status.CurrentBatchRemediationProgress[clusterName].FirstCompliantAt
+			var soakResult bool
+			// The soak time set in the CGU takes precedence over the policy annotation
+			if override := utils.GetPolicyOverride(clusterGroupUpgrade, currentManagedPolicy.GetName()); override != nil && override.SoakSeconds != nil {
+				soakResult = utils.ShouldSoakFor(*override.SoakSeconds, firstCompliantAt)
+			} else {
+				soakResult, err = shouldSoak(currentManagedPolicy, firstCompliantAt)
+				if err != nil {
+					r.Log.Info(err.Error())
+					continue
+				}
 			}
 			if !soakResult {
 				clusterGroupUpgrade.Status.Status.CurrentBatchRemediationProgress[clusterName].FirstCompliantAt = metav1.Time{}
@@ -901,6 +954,7 @@ func (r *ClusterGroupUpgradeReconciler) rootPolicyHandlerOnUpdate(ctx context.Co
 				continue
 			}
 
+			useInheritedRollout(&cgu)
 			// This policy is not in this CGU, continue searching in rest of CGUs
 			if _, ok := utils.FindStringInSlice(cgu.Spec.ManagedPolicies, newPolicy.Name); !ok {
 				continue
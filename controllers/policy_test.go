@@ -363,6 +363,7 @@ func TestGetNextNonCompliantPolicyForCluster(t *testing.T) {
 // Comprehensive test for soaking behavior with proper mocking
 func TestGetNextNonCompliantPolicyForCluster_SoakingBehavior(t *testing.T) {
 	ctx := context.Background()
+	overriddenSoakSeconds := 600
 
 	tests := []struct {
 		name                        string
@@ -507,6 +508,39 @@ func TestGetNextNonCompliantPolicyForCluster_SoakingBehavior(t *testing.T) {
 			expectedSoaking: false,
 			expectedError:   false,
 		},
+		{
+			name: "CGU soak override takes precedence over the policy",
+			clusterGroupUpgrade: &ranv1alpha1.ClusterGroupUpgrade{
+				Spec: ranv1alpha1.ClusterGroupUpgradeSpec{
+					PolicyOverrides: []ranv1alpha1.PolicyOverride{{Name: "policy1", SoakSeconds: &overriddenSoakSeconds}},
+				},
+				Status: ranv1alpha1.ClusterGroupUpgradeStatus{
+					ManagedPoliciesForUpgrade: []ranv1alpha1.ManagedPolicyForUpgrade{
+						{Name: "policy1", Namespace: "namespace1"},
+						{Name: "policy2", Namespace: "namespace2"},
+					},
+					Status: ranv1alpha1.UpgradeStatus{
+						CurrentBatchRemediationProgress: map[string]*ranv1alpha1.ClusterRemediationProgress{
+							"cluster1": {
+								FirstCompliantAt: metav1.Time{Time: metav1.Now().Add(-5 * time.Minute)},
+							},
+						},
+					},
+				},
+			},
+			clusterName: "cluster1",
+			startIndex:  0,
+			getClusterComplianceFunc: func(clusterName string, policy *unstructured.Unstructured) string {
+				return utils.ClusterStatusCompliant
+			},
+			shouldSoakFunc: func(policy *unstructured.Unstructured, firstCompliantAt metav1.Time) (bool, error) {
+				return false, nil // The policy annotation is not consulted for policy1
+			},
+			expectedIndex:             0,
+			expectedSoaking:           true,
+			expectedError:             false,
+			expectFirstCompliantAtSet: true,
+		},
 		{
 			name: "multiple policies with early soaking termination",
 			clusterGroupUpgrade: &ranv1alpha1.ClusterGroupUpgrade{
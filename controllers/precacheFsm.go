@@ -22,6 +22,7 @@ import (
 
 	"github.com/openshift-kni/cluster-group-upgrades-operator/controllers/utils"
 	ranv1alpha1 "github.com/openshift-kni/cluster-group-upgrades-operator/pkg/api/clustergroupupgrades/v1alpha1"
+	"go.opentelemetry.io/otel/trace"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
@@ -58,7 +59,9 @@ const (
 // precachingFsm implements the precaching state machine
 // returns: error
 func (r *ClusterGroupUpgradeReconciler) precachingFsm(ctx context.Context,
-	clusterGroupUpgrade *ranv1alpha1.ClusterGroupUpgrade, clusters []string, policies []*unstructured.Unstructured) error {
+	clusterGroupUpgrade *ranv1alpha1.ClusterGroupUpgrade, clusters []string, policies []*unstructured.Unstructured) (err error) {
+	ctx, span := utils.StartCGUSpan(ctx, "precachingFsm", clusterGroupUpgrade)
+	defer func() { utils.EndSpan(span, err) }()
 
 	specCondition := meta.FindStatusCondition(clusterGroupUpgrade.Status.Conditions, utils.PrecacheSpecValidCondition)
 	if specCondition == nil || specCondition.Status == metav1.ConditionFalse {
@@ -144,14 +147,19 @@ func (r *ClusterGroupUpgradeReconciler) precachingFsm(ctx context.Context,
 		}
 		if err != nil {
 			r.Log.Info("[precachingFsm]", "cluster", cluster, "err", err)
-			// Stop retrying on err and transition to the final state if CGU has been enabled
-			if *clusterGroupUpgrade.Spec.Enable {
+			// Stop retrying on err and transition to the final state if CGU has been enabled and is not scheduled for later
+			if *clusterGroupUpgrade.Spec.Enable && scheduledStartDelay(clusterGroupUpgrade) <= 0 {
 				nextState = PrecacheStateError
 			}
 		}
 		clusterGroupUpgrade.Status.Precaching.Status[cluster] = nextState
 		if currentState != nextState {
 			r.Log.Info("[precachingFsm]", "previousState", currentState, "nextState", nextState, "cluster", cluster)
+			span.AddEvent("precachingStateTransition", trace.WithAttributes(
+				utils.TraceAttributeCluster.String(cluster),
+				utils.TraceAttributePreviousState.String(currentState),
+				utils.TraceAttributeNextState.String(nextState),
+			))
 		}
 		if nextState == PrecacheStateSucceeded {
 			// cleanup for succeeded clusters
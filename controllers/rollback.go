@@ -0,0 +1,217 @@
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	utils "github.com/openshift-kni/cluster-group-upgrades-operator/controllers/utils"
+	ranv1alpha1 "github.com/openshift-kni/cluster-group-upgrades-operator/pkg/api/clustergroupupgrades/v1alpha1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// shouldRollbackCanaries checks whether the canary clusters are rolled back when the canary batches time out
+func shouldRollbackCanaries(clusterGroupUpgrade *ranv1alpha1.ClusterGroupUpgrade) bool {
+	return clusterGroupUpgrade.Spec.RemediationStrategy.OnCanaryFailure == ranv1alpha1.OnCanaryFailureAction.Rollback &&
+		clusterGroupUpgrade.RolloutType() == ranv1alpha1.RolloutTypes.Policy
+}
+
+// getRemediatedCanaries returns the canary clusters whose remediation started: the ones that completed or timed
+// out, and the ones in progress in the current batch. The canaries that were deferred or skipped are left out.
+func getRemediatedCanaries(clusterGroupUpgrade *ranv1alpha1.ClusterGroupUpgrade) []string {
+	clusterStates := make(map[string]string, len(clusterGroupUpgrade.Status.Clusters))
+	for _, clusterState := range clusterGroupUpgrade.Status.Clusters {
+		clusterStates[clusterState.Name] = clusterState.State
+	}
+	var canaries []string
+	for _, canary := range clusterGroupUpgrade.Spec.RemediationStrategy.Canaries {
+		switch clusterStates[canary] {
+		case utils.ClusterRemediationComplete, utils.ClusterRemediationTimedout:
+			canaries = append(canaries, canary)
+			continue
+		}
+		progress := clusterGroupUpgrade.Status.Status.CurrentBatchRemediationProgress[canary]
+		if progress != nil && progress.State != ranv1alpha1.NotStarted {
+			canaries = append(canaries, canary)
+		}
+	}
+	return canaries
+}
+
+// startCanaryRollback stops enforcing the managed policies on the canary clusters and enforces the rollback
+// policies on them instead.
+func (r *ClusterGroupUpgradeReconciler) startCanaryRollback(
+	ctx context.Context, clusterGroupUpgrade *ranv1alpha1.ClusterGroupUpgrade) error {
+
+	clusters := getRemediatedCanaries(clusterGroupUpgrade)
+	r.Log.Info("[startCanaryRollback] Rolling back the canary clusters", "clusters", clusters)
+
+	if err := r.cleanupPlacements(ctx, clusterGroupUpgrade); err != nil {
+		return err
+	}
+
+	// Rollback policies are found through their child policies on the canaries, like the managed policies
+	childPolicies, err := utils.GetChildPolicies(ctx, r.Client, clusters)
+	if err != nil {
+		return err
+	}
+	policiesNs := make(map[string]string)
+	for _, childPolicy := range childPolicies {
+		policyNameArr, err := utils.GetParentPolicyNameAndNamespace(childPolicy.Name)
+		if err != nil {
+			continue
+		}
+		policiesNs[policyNameArr[1]] = policyNameArr[0]
+	}
+
+	rollback := &ranv1alpha1.RollbackStatus{StartedAt: metav1.Now(), Clusters: clusters}
+	clusterGroupUpgrade.Status.Rollback = rollback
+	var missingPolicies []string
+	for _, policyName := range clusterGroupUpgrade.Spec.RemediationStrategy.RollbackPolicies {
+		policyNs, ok := policiesNs[policyName]
+		if !ok {
+			missingPolicies = append(missingPolicies, policyName)
+			continue
+		}
+		policy, err := r.getPolicyByName(ctx, policyName, policyNs)
+		if err != nil {
+			if errors.IsNotFound(err) {
+				missingPolicies = append(missingPolicies, policyName)
+				continue
+			}
+			return err
+		}
+		if err := r.ensureRollbackPlacement(ctx, clusterGroupUpgrade, policy, clusters); err != nil {
+			return err
+		}
+		rollback.Policies = append(rollback.Policies,
+			ranv1alpha1.ManagedPolicyForUpgrade{Name: policyName, Namespace: policyNs})
+	}
+
+	if len(missingPolicies) > 0 {
+		r.finishCanaryRollback(clusterGroupUpgrade, utils.ConditionReasons.NotAllManagedPoliciesExist,
+			fmt.Sprintf("Missing rollback policies: %v", missingPolicies))
+		return nil
+	}
+
+	utils.SetStatusCondition(
+		&clusterGroupUpgrade.Status.Conditions,
+		utils.ConditionTypes.RolledBack,
+		utils.ConditionReasons.InProgress,
+		metav1.ConditionFalse,
+		"Enforcing the rollback policies on the canary clusters",
+	)
+	utils.SetStatusCondition(
+		&clusterGroupUpgrade.Status.Conditions,
+		utils.ConditionTypes.Progressing,
+		utils.ConditionReasons.RollingBack,
+		metav1.ConditionTrue,
+		utils.TimeoutMessages[clusterGroupUpgrade.RolloutType()]+" on canary clusters, rolling them back",
+	)
+	return nil
+}
+
+// ensureRollbackPlacement creates the Placement and PlacementBinding enforcing a rollback policy on the canary clusters
+func (r *ClusterGroupUpgradeReconciler) ensureRollbackPlacement(
+	ctx context.Context, clusterGroupUpgrade *ranv1alpha1.ClusterGroupUpgrade, policy *unstructured.Unstructured,
+	clusters []string) error {
+
+	name := utils.GetResourceName(clusterGroupUpgrade, policy.GetName()+"-rollback")
+	safeName := utils.GetSafeResourceName(name, policy.GetNamespace(), clusterGroupUpgrade, utils.MaxObjectNameLength)
+
+	placement := r.newBatchPlacement(clusterGroupUpgrade, policy.GetName(), policy.GetNamespace(), safeName, name)
+	if err := utils.SetPlacementClusterNames(placement, clusters); err != nil {
+		return err
+	}
+	if err := r.Create(ctx, placement); client.IgnoreAlreadyExists(err) != nil {
+		return err
+	}
+
+	placementBinding := r.newBatchPlacementBinding(
+		clusterGroupUpgrade, policy.GetName(), policy.GetNamespace(), safeName, safeName, name)
+	if err := r.Create(ctx, placementBinding); client.IgnoreAlreadyExists(err) != nil {
+		return err
+	}
+	return nil
+}
+
+// reconcileCanaryRollback ends the CGU once the canary clusters are compliant with the rollback policies or once
+// the rollback has taken longer than a batch.
+func (r *ClusterGroupUpgradeReconciler) reconcileCanaryRollback(
+	ctx context.Context, clusterGroupUpgrade *ranv1alpha1.ClusterGroupUpgrade) error {
+
+	rollback := clusterGroupUpgrade.Status.Rollback
+	if rollback.CompletedAt != nil {
+		return nil
+	}
+
+	isRolledBack := true
+	for _, policyForRollback := range rollback.Policies {
+		policy, err := r.getPolicyByName(ctx, policyForRollback.Name, policyForRollback.Namespace)
+		if err != nil {
+			if errors.IsNotFound(err) {
+				isRolledBack = false
+				continue
+			}
+			return err
+		}
+		for _, cluster := range rollback.Clusters {
+			if r.getClusterComplianceWithPolicy(cluster, policy) != utils.ClusterStatusCompliant {
+				isRolledBack = false
+			}
+		}
+	}
+
+	rollbackTimeout := time.Duration(clusterGroupUpgrade.Spec.RemediationStrategy.Timeout) * time.Minute
+	if len(clusterGroupUpgrade.Status.RemediationPlan) > 0 {
+		rollbackTimeout /= time.Duration(len(clusterGroupUpgrade.Status.RemediationPlan))
+	}
+
+	switch {
+	case isRolledBack:
+		r.finishCanaryRollback(clusterGroupUpgrade, utils.ConditionReasons.Completed,
+			"The canary clusters are compliant with the rollback policies")
+	case time.Since(rollback.StartedAt.Time) > rollbackTimeout:
+		r.finishCanaryRollback(clusterGroupUpgrade, utils.ConditionReasons.TimedOut,
+			"The canary clusters did not become compliant with the rollback policies in time")
+	}
+	return nil
+}
+
+// finishCanaryRollback records the result of the rollback and ends the CGU
+func (r *ClusterGroupUpgradeReconciler) finishCanaryRollback(
+	clusterGroupUpgrade *ranv1alpha1.ClusterGroupUpgrade, reason utils.ConditionReason, message string) {
+
+	r.Log.Info("[finishCanaryRollback] Rollback finished", "reason", reason, "message", message)
+	now := metav1.Now()
+	clusterGroupUpgrade.Status.Rollback.CompletedAt = &now
+
+	status := metav1.ConditionFalse
+	if reason == utils.ConditionReasons.Completed {
+		status = metav1.ConditionTrue
+	}
+	utils.SetStatusCondition(
+		&clusterGroupUpgrade.Status.Conditions,
+		utils.ConditionTypes.RolledBack,
+		reason,
+		status,
+		message,
+	)
+	utils.SetStatusCondition(
+		&clusterGroupUpgrade.Status.Conditions,
+		utils.ConditionTypes.Progressing,
+		utils.ConditionReasons.CanaryFailed,
+		metav1.ConditionFalse,
+		utils.TimeoutMessages[clusterGroupUpgrade.RolloutType()]+" on canary clusters",
+	)
+	utils.SetStatusCondition(
+		&clusterGroupUpgrade.Status.Conditions,
+		utils.ConditionTypes.Succeeded,
+		utils.ConditionReasons.CanaryFailed,
+		metav1.ConditionFalse,
+		utils.TimeoutMessages[clusterGroupUpgrade.RolloutType()]+" on canary clusters",
+	)
+}
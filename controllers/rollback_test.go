@@ -0,0 +1,127 @@
+package controllers
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/openshift-kni/cluster-group-upgrades-operator/controllers/utils"
+	"github.com/openshift-kni/cluster-group-upgrades-operator/pkg/api/clustergroupupgrades/v1alpha1"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/api/meta"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clusterv1beta1 "open-cluster-management.io/api/cluster/v1beta1"
+	policiesv1 "open-cluster-management.io/governance-policy-propagator/api/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func TestClusterGroupUpgradeReconciler_canaryRollback(t *testing.T) {
+	rollbackPolicy := &policiesv1.Policy{
+		ObjectMeta: v1.ObjectMeta{Name: "rollback-policy", Namespace: "policies"},
+		Status: policiesv1.PolicyStatus{
+			Status: []*policiesv1.CompliancePerClusterStatus{
+				{ClusterName: "spoke1", ClusterNamespace: "spoke1", ComplianceState: policiesv1.NonCompliant},
+			},
+		},
+	}
+	childPolicy := &policiesv1.Policy{
+		ObjectMeta: v1.ObjectMeta{
+			Name:      "policies.rollback-policy",
+			Namespace: "spoke1",
+			Labels:    map[string]string{utils.ChildPolicyLabel: "policies.rollback-policy"},
+		},
+	}
+	fakeClient, err := getFakeClientFromObjects(rollbackPolicy, childPolicy)
+	if err != nil {
+		t.Fatalf("error creating fake client: %v", err)
+	}
+	r := &ClusterGroupUpgradeReconciler{Client: fakeClient, Log: logr.Discard(), Scheme: testscheme}
+
+	cgu := &v1alpha1.ClusterGroupUpgrade{
+		ObjectMeta: v1.ObjectMeta{Name: "cgu", Namespace: "namespace"},
+		Spec: v1alpha1.ClusterGroupUpgradeSpec{
+			ManagedPolicies: []string{"upgrade-policy"},
+			RemediationStrategy: &v1alpha1.RemediationStrategySpec{
+				Canaries:         []string{"spoke1", "spoke2"},
+				OnCanaryFailure:  v1alpha1.OnCanaryFailureAction.Rollback,
+				RollbackPolicies: []string{"rollback-policy"},
+				Timeout:          60,
+			},
+		},
+		Status: v1alpha1.ClusterGroupUpgradeStatus{
+			// The spoke2 canary was deferred, so the second batch, which timed out, isn't a canary one
+			RemediationPlan: [][]string{{"spoke1"}, {"spoke3"}, {"spoke2"}},
+			Clusters:        []v1alpha1.ClusterState{{Name: "spoke1", State: utils.ClusterRemediationComplete}},
+			Status: v1alpha1.UpgradeStatus{
+				CurrentBatch: 2,
+				CurrentBatchRemediationProgress: map[string]*v1alpha1.ClusterRemediationProgress{
+					"spoke3": {State: v1alpha1.InProgress},
+				},
+			},
+		},
+	}
+	assert.True(t, shouldRollbackCanaries(cgu))
+
+	err = r.startCanaryRollback(context.TODO(), cgu)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"spoke1"}, cgu.Status.Rollback.Clusters)
+	assert.Equal(t, []v1alpha1.ManagedPolicyForUpgrade{{Name: "rollback-policy", Namespace: "policies"}}, cgu.Status.Rollback.Policies)
+	assert.True(t, meta.IsStatusConditionTrue(cgu.Status.Conditions, string(utils.ConditionTypes.Progressing)))
+	assert.Equal(t, string(utils.ConditionReasons.InProgress),
+		meta.FindStatusCondition(cgu.Status.Conditions, string(utils.ConditionTypes.RolledBack)).Reason)
+
+	placements := &clusterv1beta1.PlacementList{}
+	assert.NoError(t, fakeClient.List(context.TODO(), placements, client.InNamespace("policies")))
+	assert.Len(t, placements.Items, 1)
+	placementClusters, err := utils.GetPlacementClusterNames(&placements.Items[0])
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"spoke1"}, placementClusters)
+	placementBindings := &policiesv1.PlacementBindingList{}
+	assert.NoError(t, fakeClient.List(context.TODO(), placementBindings, client.InNamespace("policies")))
+	assert.Len(t, placementBindings.Items, 1)
+
+	// Still NonCompliant, the rollback goes on
+	err = r.reconcileCanaryRollback(context.TODO(), cgu)
+	assert.NoError(t, err)
+	assert.Nil(t, cgu.Status.Rollback.CompletedAt)
+
+	rollbackPolicy.Status.Status[0].ComplianceState = policiesv1.Compliant
+	assert.NoError(t, fakeClient.Update(context.TODO(), rollbackPolicy))
+	err = r.reconcileCanaryRollback(context.TODO(), cgu)
+	assert.NoError(t, err)
+	assert.NotNil(t, cgu.Status.Rollback.CompletedAt)
+	assert.True(t, meta.IsStatusConditionTrue(cgu.Status.Conditions, string(utils.ConditionTypes.RolledBack)))
+	succeededCondition := meta.FindStatusCondition(cgu.Status.Conditions, string(utils.ConditionTypes.Succeeded))
+	assert.Equal(t, v1.ConditionFalse, succeededCondition.Status)
+	assert.Equal(t, string(utils.ConditionReasons.CanaryFailed), succeededCondition.Reason)
+}
+
+func TestClusterGroupUpgradeReconciler_canaryRollbackTimeout(t *testing.T) {
+	fakeClient, err := getFakeClientFromObjects()
+	if err != nil {
+		t.Fatalf("error creating fake client: %v", err)
+	}
+	r := &ClusterGroupUpgradeReconciler{Client: fakeClient, Log: logr.Discard(), Scheme: testscheme}
+
+	cgu := &v1alpha1.ClusterGroupUpgrade{
+		ObjectMeta: v1.ObjectMeta{Name: "cgu", Namespace: "namespace"},
+		Spec: v1alpha1.ClusterGroupUpgradeSpec{
+			RemediationStrategy: &v1alpha1.RemediationStrategySpec{Timeout: 60},
+		},
+		Status: v1alpha1.ClusterGroupUpgradeStatus{
+			RemediationPlan: [][]string{{"spoke1"}, {"spoke2"}},
+			Rollback: &v1alpha1.RollbackStatus{
+				StartedAt: v1.NewTime(time.Now().Add(-time.Hour)),
+				Clusters:  []string{"spoke1"},
+				Policies:  []v1alpha1.ManagedPolicyForUpgrade{{Name: "rollback-policy", Namespace: "policies"}},
+			},
+		},
+	}
+	err = r.reconcileCanaryRollback(context.TODO(), cgu)
+	assert.NoError(t, err)
+	assert.NotNil(t, cgu.Status.Rollback.CompletedAt)
+	rolledBackCondition := meta.FindStatusCondition(cgu.Status.Conditions, string(utils.ConditionTypes.RolledBack))
+	assert.Equal(t, v1.ConditionFalse, rolledBackCondition.Status)
+	assert.Equal(t, string(utils.ConditionReasons.TimedOut), rolledBackCondition.Reason)
+}
@@ -0,0 +1,149 @@
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"strings"
+
+	"github.com/go-logr/logr"
+	ranv1alpha1 "github.com/openshift-kni/cluster-group-upgrades-operator/pkg/api/clustergroupupgrades/v1alpha1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+)
+
+const (
+	// UpgradeAlertsConfigName is the name of the only UpgradeAlertsConfig taken into account
+	UpgradeAlertsConfigName = "default"
+	upgradeAlertsRuleName   = "cluster-group-upgrades-alerts"
+	upgradeAlertsGroupName  = "cluster-group-upgrades.rules"
+)
+
+var prometheusRuleGroupVersionKind = schema.GroupVersionKind{
+	Group: "monitoring.coreos.com", Version: "v1", Kind: "PrometheusRule"}
+
+// UpgradeAlertsConfigReconciler reconciles the UpgradeAlertsConfig into a PrometheusRule alerting on the ClusterGroupUpgrades
+type UpgradeAlertsConfigReconciler struct {
+	client.Client
+	Log    logr.Logger
+	Scheme *runtime.Scheme
+	// Namespace of the PrometheusRule, the one of the operator
+	Namespace string
+}
+
+//+kubebuilder:rbac:groups=ran.openshift.io,resources=upgradealertsconfigs,verbs=get;list;watch
+//+kubebuilder:rbac:groups=monitoring.coreos.com,resources=prometheusrules,verbs=get;list;watch;create;update;patch;delete
+
+// Reconcile creates or updates the PrometheusRule from the UpgradeAlertsConfig. The PrometheusRule is owned by the
+// UpgradeAlertsConfig, so it is garbage collected once the UpgradeAlertsConfig is deleted.
+func (r *UpgradeAlertsConfigReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	if req.Name != UpgradeAlertsConfigName {
+		return ctrl.Result{}, nil
+	}
+	alertsConfig := &ranv1alpha1.UpgradeAlertsConfig{}
+	if err := r.Get(ctx, req.NamespacedName, alertsConfig); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	rule := &unstructured.Unstructured{}
+	rule.SetGroupVersionKind(prometheusRuleGroupVersionKind)
+	rule.SetName(upgradeAlertsRuleName)
+	rule.SetNamespace(r.Namespace)
+	result, err := controllerutil.CreateOrUpdate(ctx, r.Client, rule, func() error {
+		labels := map[string]string{}
+		maps.Copy(labels, alertsConfig.Spec.RuleLabels)
+		labels["app.kubernetes.io/name"] = "talm-operator"
+		labels["app.kubernetes.io/component"] = "talm"
+		rule.SetLabels(labels)
+		if err := unstructured.SetNestedField(rule.Object, map[string]interface{}{
+			"groups": []interface{}{map[string]interface{}{
+				"name":  upgradeAlertsGroupName,
+				"rules": upgradeAlertingRules(&alertsConfig.Spec),
+			}},
+		}, "spec"); err != nil {
+			return err
+		}
+		return controllerutil.SetControllerReference(alertsConfig, rule, r.Scheme)
+	})
+	if err != nil {
+		if meta.IsNoMatchError(err) {
+			r.Log.Info("[Reconcile] PrometheusRule CRD not found, is the Prometheus operator installed?")
+		}
+		return ctrl.Result{}, err
+	}
+	r.Log.Info("[Reconcile] PrometheusRule reconciled", "name", upgradeAlertsRuleName, "namespace", r.Namespace, "result", result)
+	return ctrl.Result{}, nil
+}
+
+// upgradeAlertingRules returns the alerting rules built from the thresholds of the UpgradeAlertsConfig
+func upgradeAlertingRules(spec *ranv1alpha1.UpgradeAlertsConfigSpec) []interface{} {
+	alert := func(name, expr, severity, summary, description string) interface{} {
+		return map[string]interface{}{
+			"alert":  name,
+			"expr":   expr,
+			"labels": map[string]interface{}{"severity": severity},
+			"annotations": map[string]interface{}{
+				"summary":     summary,
+				"description": description,
+			},
+		}
+	}
+
+	return []interface{}{
+		alert("ClusterGroupUpgradeNearTimeout",
+			fmt.Sprintf("(time() - talm_cgu_start_time_seconds) > talm_cgu_timeout_seconds * %d / 100",
+				spec.ProgressingTimeoutPercent),
+			"warning",
+			"ClusterGroupUpgrade is close to its timeout",
+			fmt.Sprintf("ClusterGroupUpgrade {{ $labels.namespace }}/{{ $labels.name }} has been progressing for more than %d%% of its timeout.",
+				spec.ProgressingTimeoutPercent)),
+		alert("ClusterGroupUpgradeBatchTimedOut",
+			fmt.Sprintf(`increase(talm_cgu_timeouts_total{scope="%s"}[15m]) > 0`, metricsScopeBatch),
+			"warning",
+			"ClusterGroupUpgrade batch timed out",
+			"A batch of ClusterGroupUpgrade {{ $labels.namespace }}/{{ $labels.name }} timed out in the last 15 minutes."),
+		alert("ClusterGroupUpgradeCanaryFailed",
+			"increase(talm_cgu_canary_failures_total[15m]) > 0",
+			"critical",
+			"ClusterGroupUpgrade canaries failed",
+			"The canary clusters of ClusterGroupUpgrade {{ $labels.namespace }}/{{ $labels.name }} failed in the last 15 minutes, the upgrade was stopped or rolled back."),
+		alert("ClusterGroupUpgradePrecachingFailures",
+			fmt.Sprintf(`sum by (namespace, name) (talm_cgu_precaching_clusters{state=~"%s"}) > %d`,
+				strings.Join(metricsPrecachingFailedStates, "|"), spec.PrecachingFailedClusters),
+			"warning",
+			"ClusterGroupUpgrade pre-caching failures",
+			fmt.Sprintf("The pre-caching of more than %d clusters of ClusterGroupUpgrade {{ $labels.namespace }}/{{ $labels.name }} failed.",
+				spec.PrecachingFailedClusters)),
+		alert("ClusterGroupUpgradeClusterStuck",
+			fmt.Sprintf("(time() - talm_cgu_oldest_policy_start_time_seconds) > %d", spec.StuckPolicyMinutes*60),
+			"warning",
+			"ClusterGroupUpgrade cluster stuck at a policy",
+			fmt.Sprintf("A cluster of ClusterGroupUpgrade {{ $labels.namespace }}/{{ $labels.name }} has been at the same managed policy for more than %d minutes.",
+				spec.StuckPolicyMinutes)),
+	}
+}
+
+// SetupWithManager sets up the controller with the Manager. The PrometheusRule is watched so that it is restored
+// when it is modified or deleted, unless its CRD is not installed.
+func (r *UpgradeAlertsConfigReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	builder := ctrl.NewControllerManagedBy(mgr).
+		For(&ranv1alpha1.UpgradeAlertsConfig{})
+
+	_, err := mgr.GetRESTMapper().RESTMapping(prometheusRuleGroupVersionKind.GroupKind(), prometheusRuleGroupVersionKind.Version)
+	switch {
+	case err == nil:
+		rule := &unstructured.Unstructured{}
+		rule.SetGroupVersionKind(prometheusRuleGroupVersionKind)
+		builder = builder.Owns(rule)
+	case meta.IsNoMatchError(err):
+		r.Log.Info("PrometheusRule CRD not found, the PrometheusRule changes are not watched")
+	default:
+		return err
+	}
+	return builder.Complete(r)
+}
@@ -0,0 +1,69 @@
+package controllers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/openshift-kni/cluster-group-upgrades-operator/pkg/api/clustergroupupgrades/v1alpha1"
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+func TestUpgradeAlertsConfigReconciler(t *testing.T) {
+	alertsConfig := &v1alpha1.UpgradeAlertsConfig{
+		ObjectMeta: v1.ObjectMeta{Name: UpgradeAlertsConfigName},
+		Spec: v1alpha1.UpgradeAlertsConfigSpec{
+			ProgressingTimeoutPercent: 75,
+			PrecachingFailedClusters:  2,
+			StuckPolicyMinutes:        30,
+			RuleLabels:                map[string]string{"role": "alert-rules"},
+		},
+	}
+	fakeClient, err := getFakeClientFromObjects(alertsConfig)
+	assert.NoError(t, err)
+	r := &UpgradeAlertsConfigReconciler{Client: fakeClient, Scheme: testscheme, Log: logr.Discard(), Namespace: "talm"}
+
+	getRuleExprs := func() map[string]string {
+		rule := &unstructured.Unstructured{}
+		rule.SetGroupVersionKind(prometheusRuleGroupVersionKind)
+		assert.NoError(t, fakeClient.Get(context.TODO(), types.NamespacedName{Name: upgradeAlertsRuleName, Namespace: "talm"}, rule))
+		assert.Equal(t, "alert-rules", rule.GetLabels()["role"])
+		assert.Equal(t, UpgradeAlertsConfigName, rule.GetOwnerReferences()[0].Name)
+		groups, _, _ := unstructured.NestedSlice(rule.Object, "spec", "groups")
+		assert.Len(t, groups, 1)
+		exprs := make(map[string]string)
+		for _, alert := range groups[0].(map[string]interface{})["rules"].([]interface{}) {
+			exprs[alert.(map[string]interface{})["alert"].(string)] = alert.(map[string]interface{})["expr"].(string)
+		}
+		return exprs
+	}
+
+	_, err = r.Reconcile(context.TODO(), ctrl.Request{NamespacedName: types.NamespacedName{Name: UpgradeAlertsConfigName}})
+	assert.NoError(t, err)
+	exprs := getRuleExprs()
+	assert.Len(t, exprs, 5)
+	assert.Equal(t, "(time() - talm_cgu_start_time_seconds) > talm_cgu_timeout_seconds * 75 / 100",
+		exprs["ClusterGroupUpgradeNearTimeout"])
+	assert.Equal(t, `increase(talm_cgu_timeouts_total{scope="batch"}[15m]) > 0`, exprs["ClusterGroupUpgradeBatchTimedOut"])
+	assert.Equal(t, "increase(talm_cgu_canary_failures_total[15m]) > 0", exprs["ClusterGroupUpgradeCanaryFailed"])
+	assert.Equal(t, `sum by (namespace, name) (talm_cgu_precaching_clusters{state=~"precachetimeout|unrecoverableerror"}) > 2`,
+		exprs["ClusterGroupUpgradePrecachingFailures"])
+	assert.Equal(t, "(time() - talm_cgu_oldest_policy_start_time_seconds) > 1800", exprs["ClusterGroupUpgradeClusterStuck"])
+
+	// The rule follows the thresholds
+	alertsConfig.Spec.StuckPolicyMinutes = 90
+	assert.NoError(t, fakeClient.Update(context.TODO(), alertsConfig))
+	_, err = r.Reconcile(context.TODO(), ctrl.Request{NamespacedName: types.NamespacedName{Name: UpgradeAlertsConfigName}})
+	assert.NoError(t, err)
+	assert.Equal(t, "(time() - talm_cgu_oldest_policy_start_time_seconds) > 5400", getRuleExprs()["ClusterGroupUpgradeClusterStuck"])
+
+	// Other names are ignored
+	other := &v1alpha1.UpgradeAlertsConfig{ObjectMeta: v1.ObjectMeta{Name: "other"}}
+	assert.NoError(t, fakeClient.Create(context.TODO(), other))
+	_, err = r.Reconcile(context.TODO(), ctrl.Request{NamespacedName: types.NamespacedName{Name: "other"}})
+	assert.NoError(t, err)
+}
@@ -1,9 +1,11 @@
 package utils
 
 import (
+	"fmt"
 	"time"
 
 	ranv1alpha1 "github.com/openshift-kni/cluster-group-upgrades-operator/pkg/api/clustergroupupgrades/v1alpha1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 )
 
 // CalculateBatchTimeout calculates the current batch timeout for the running cgu
@@ -30,6 +32,188 @@ func CalculateBatchTimeout(timeoutMinutes, numBatches, currentBatch int, current
 	return currentBatchTimeout
 }
 
+// CalculateWeightedBatchTimeout calculates the current batch timeout for a remediation plan with batches of
+// different sizes. The remaining time is shared between the remaining batches in proportion to their size.
+func CalculateWeightedBatchTimeout(timeoutMinutes int, remediationPlan [][]string, currentBatch int, currentBatchStartTime, cguStartTime time.Time) time.Duration {
+	remainingTime := float64(timeoutMinutes)*float64(time.Minute) - float64(currentBatchStartTime.Sub(cguStartTime).Nanoseconds())
+
+	// The current batch index starts at 1
+	if currentBatch < 1 || currentBatch > len(remediationPlan) {
+		return time.Duration(remainingTime)
+	}
+
+	remainingClusters := 0
+	for _, batch := range remediationPlan[currentBatch-1:] {
+		remainingClusters += len(batch)
+	}
+	if remainingClusters == 0 {
+		return time.Duration(remainingTime)
+	}
+
+	return time.Duration(remainingTime * float64(len(remediationPlan[currentBatch-1])) / float64(remainingClusters))
+}
+
+// GetMaxConcurrency resolves maxConcurrency, or maxConcurrencyPercentage when set, against the number of
+// selected clusters. The percentage is rounded up, the result is capped to the number of clusters, and values
+// lower than 1 mean all clusters.
+func GetMaxConcurrency(maxConcurrency, maxConcurrencyPercentage, totalClusters int) int {
+	value := maxConcurrency
+	if maxConcurrencyPercentage > 0 {
+		value = (maxConcurrencyPercentage*totalClusters + 99) / 100
+	}
+	if value <= 0 || value > totalClusters {
+		return totalClusters
+	}
+	return value
+}
+
+// GetBatchSizes resolves the progressive batch sizes against the number of selected clusters.
+// Percentages are rounded up and every batch has at least one cluster.
+func GetBatchSizes(batchSizes []intstr.IntOrString, totalClusters int) ([]int, error) {
+	sizes := make([]int, 0, len(batchSizes))
+	for i := range batchSizes {
+		value, err := intstr.GetScaledValueFromIntOrPercent(&batchSizes[i], totalClusters, true)
+		if err != nil {
+			return nil, fmt.Errorf("invalid batchSizes entry %s: %w", batchSizes[i].String(), err)
+		}
+		if value < 1 {
+			return nil, fmt.Errorf("invalid batchSizes entry %s: must be at least 1", batchSizes[i].String())
+		}
+		sizes = append(sizes, value)
+	}
+	return sizes, nil
+}
+
+// SplitIntoBatches splits the clusters into batches, keeping their order. The first batches follow
+// batchSizes, the clusters left afterwards are split into batches of maxConcurrency.
+func SplitIntoBatches(clusters []string, batchSizes []int, maxConcurrency int) [][]string {
+	var batches [][]string
+	for len(clusters) > 0 {
+		size := min(batchCapacity(len(batches), batchSizes, maxConcurrency, len(clusters)), len(clusters))
+		batches = append(batches, clusters[:size])
+		clusters = clusters[size:]
+	}
+	return batches
+}
+
+// SpreadIntoBatches splits the clusters into batches like SplitIntoBatches, but puts at most maxPerBatch
+// clusters of the same topology domain in a batch. The domains map holds the domain of each cluster, clusters
+// without domain are not restricted. A batch that cannot be filled without breaking the rule is left smaller.
+func SpreadIntoBatches(clusters []string, domains map[string]string, maxPerBatch int, batchSizes []int, maxConcurrency int) [][]string {
+	maxPerBatch = max(maxPerBatch, 1)
+	var batches [][]string
+	for len(clusters) > 0 {
+		size := batchCapacity(len(batches), batchSizes, maxConcurrency, len(clusters))
+		var batch, skipped []string
+		perDomain := make(map[string]int)
+		for _, cluster := range clusters {
+			domain, hasDomain := domains[cluster]
+			if len(batch) < size && (!hasDomain || perDomain[domain] < maxPerBatch) {
+				batch = append(batch, cluster)
+				perDomain[domain]++
+			} else {
+				skipped = append(skipped, cluster)
+			}
+		}
+		batches = append(batches, batch)
+		clusters = skipped
+	}
+	return batches
+}
+
+// GroupIntoBatches splits the clusters into batches so that all the clusters of a topology domain are in
+// the same batch. Domains are packed together, in the order they first appear, as long as the batch size
+// is not exceeded; a domain bigger than the batch size gets a batch of its own. Clusters without domain
+// are batched last with SplitIntoBatches.
+func GroupIntoBatches(clusters []string, domains map[string]string, batchSizes []int, maxConcurrency int) [][]string {
+	var groups [][]string
+	var clustersWithoutDomain []string
+	groupIndex := make(map[string]int)
+	for _, cluster := range clusters {
+		domain, hasDomain := domains[cluster]
+		if !hasDomain {
+			clustersWithoutDomain = append(clustersWithoutDomain, cluster)
+			continue
+		}
+		if i, found := groupIndex[domain]; found {
+			groups[i] = append(groups[i], cluster)
+		} else {
+			groupIndex[domain] = len(groups)
+			groups = append(groups, []string{cluster})
+		}
+	}
+
+	var batches [][]string
+	var batch []string
+	for _, group := range groups {
+		size := batchCapacity(len(batches), batchSizes, maxConcurrency, len(clusters))
+		if len(batch) > 0 && len(batch)+len(group) > size {
+			batches = append(batches, batch)
+			batch = nil
+		}
+		batch = append(batch, group...)
+	}
+	if len(batch) > 0 {
+		batches = append(batches, batch)
+	}
+
+	remainingBatchSizes := batchSizes[min(len(batches), len(batchSizes)):]
+	return append(batches, SplitIntoBatches(clustersWithoutDomain, remainingBatchSizes, maxConcurrency)...)
+}
+
+// batchCapacity returns the size of the batch at the given index
+func batchCapacity(batchIndex int, batchSizes []int, maxConcurrency, totalClusters int) int {
+	size := maxConcurrency
+	if batchIndex < len(batchSizes) {
+		size = batchSizes[batchIndex]
+	}
+	if size <= 0 {
+		return totalClusters
+	}
+	return size
+}
+
+// RemoveFromRemediationPlan removes the given clusters from the batches of the remediation plan starting at
+// fromIndex. The batches after fromIndex that are left empty are dropped.
+func RemoveFromRemediationPlan(remediationPlan [][]string, fromIndex int, clusters map[string]bool) [][]string {
+	var newPlan [][]string
+	for i, batch := range remediationPlan {
+		if i < fromIndex {
+			newPlan = append(newPlan, batch)
+			continue
+		}
+		newBatch := []string{}
+		for _, cluster := range batch {
+			if !clusters[cluster] {
+				newBatch = append(newBatch, cluster)
+			}
+		}
+		if len(newBatch) > 0 || i == fromIndex {
+			newPlan = append(newPlan, newBatch)
+		}
+	}
+	return newPlan
+}
+
+// AppendToRemediationPlan appends clusters to the remediation plan. The last batch is filled up to maxConcurrency
+// if its index is at least fillFromIndex, then new batches of maxConcurrency clusters are added.
+func AppendToRemediationPlan(remediationPlan [][]string, fillFromIndex int, clusters []string, maxConcurrency int) [][]string {
+	if maxConcurrency <= 0 {
+		maxConcurrency = len(clusters)
+	}
+	for len(clusters) > 0 {
+		last := len(remediationPlan) - 1
+		if last < fillFromIndex || len(remediationPlan[last]) >= maxConcurrency {
+			remediationPlan = append(remediationPlan, []string{})
+			continue
+		}
+		count := min(maxConcurrency-len(remediationPlan[last]), len(clusters))
+		remediationPlan[last] = append(remediationPlan[last], clusters[:count]...)
+		clusters = clusters[count:]
+	}
+	return remediationPlan
+}
+
 // GetClustersListFromRemediationPlan gets the list of clusters from the remediation plan
 func GetClustersListFromRemediationPlan(clusterGroupUpgrade *ranv1alpha1.ClusterGroupUpgrade) []string {
 	var clusters []string
@@ -38,3 +222,48 @@ func GetClustersListFromRemediationPlan(clusterGroupUpgrade *ranv1alpha1.Cluster
 	}
 	return clusters
 }
+
+// GetMaxFailures returns the number of clusters allowed to fail out of the given total.
+// It returns -1 when no failure threshold is set.
+func GetMaxFailures(maxFailures *intstr.IntOrString, totalClusters int) (int, error) {
+	if maxFailures == nil {
+		return -1, nil
+	}
+	value, err := intstr.GetScaledValueFromIntOrPercent(maxFailures, totalClusters, false)
+	if err != nil {
+		return 0, fmt.Errorf("invalid maxFailures %s: %w", maxFailures.String(), err)
+	}
+	if value < 0 {
+		return 0, fmt.Errorf("invalid maxFailures %s: must not be negative", maxFailures.String())
+	}
+	return value, nil
+}
+
+// GetFailedClusters returns the clusters that did not complete remediation according to the CGU status
+func GetFailedClusters(clusterGroupUpgrade *ranv1alpha1.ClusterGroupUpgrade) []string {
+	var failedClusters []string
+	for _, clusterState := range clusterGroupUpgrade.Status.Clusters {
+		if clusterState.State == ClusterRemediationTimedout {
+			failedClusters = append(failedClusters, clusterState.Name)
+		}
+	}
+	return failedClusters
+}
+
+// IsFailureThresholdExceeded returns true if more clusters have failed than allowed by maxFailures
+func IsFailureThresholdExceeded(clusterGroupUpgrade *ranv1alpha1.ClusterGroupUpgrade) bool {
+	failedClusters := GetFailedClusters(clusterGroupUpgrade)
+	// Clusters timing out individually are removed from the remediation plan but still count in the total
+	plannedClusters := GetClustersListFromRemediationPlan(clusterGroupUpgrade)
+	totalClusters := len(plannedClusters)
+	for _, cluster := range failedClusters {
+		if _, found := FindStringInSlice(plannedClusters, cluster); !found {
+			totalClusters++
+		}
+	}
+	maxFailures, err := GetMaxFailures(clusterGroupUpgrade.Spec.RemediationStrategy.MaxFailures, totalClusters)
+	if err != nil || maxFailures < 0 {
+		return false
+	}
+	return len(failedClusters) > maxFailures
+}
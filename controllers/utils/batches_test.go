@@ -4,7 +4,9 @@ import (
 	"testing"
 	"time"
 
+	ranv1alpha1 "github.com/openshift-kni/cluster-group-upgrades-operator/pkg/api/clustergroupupgrades/v1alpha1"
 	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/util/intstr"
 )
 
 func TestBatchTimeout(t *testing.T) {
@@ -115,3 +117,345 @@ func TestBatchTimeout(t *testing.T) {
 		})
 	}
 }
+
+func TestIsFailureThresholdExceeded(t *testing.T) {
+	plan := [][]string{{"c1", "c2"}, {"c3", "c4"}, {"c5", "c6"}, {"c7", "c8"}, {"c9", "c10"}}
+
+	testcases := []struct {
+		name        string
+		maxFailures *intstr.IntOrString
+		timedout    []string
+		expected    bool
+	}{
+		{
+			name:     "No threshold",
+			timedout: []string{"c1", "c2", "c3"},
+			expected: false,
+		},
+		{
+			name:        "Number under threshold",
+			maxFailures: &intstr.IntOrString{Type: intstr.Int, IntVal: 2},
+			timedout:    []string{"c1", "c2"},
+			expected:    false,
+		},
+		{
+			name:        "Number over threshold",
+			maxFailures: &intstr.IntOrString{Type: intstr.Int, IntVal: 2},
+			timedout:    []string{"c1", "c2", "c3"},
+			expected:    true,
+		},
+		{
+			name:        "Percentage under threshold",
+			maxFailures: &intstr.IntOrString{Type: intstr.String, StrVal: "20%"},
+			timedout:    []string{"c1", "c2"},
+			expected:    false,
+		},
+		{
+			name:        "Percentage over threshold",
+			maxFailures: &intstr.IntOrString{Type: intstr.String, StrVal: "20%"},
+			timedout:    []string{"c1", "c2", "c5"},
+			expected:    true,
+		},
+		{
+			name:        "Percentage rounded down",
+			maxFailures: &intstr.IntOrString{Type: intstr.String, StrVal: "15%"},
+			timedout:    []string{"c1", "c2"},
+			expected:    true,
+		},
+		{
+			name:        "Clusters removed from the plan still count",
+			maxFailures: &intstr.IntOrString{Type: intstr.String, StrVal: "25%"},
+			timedout:    []string{"c1", "c11", "c12"},
+			expected:    false,
+		},
+		{
+			name:        "Invalid threshold is ignored",
+			maxFailures: &intstr.IntOrString{Type: intstr.String, StrVal: "abc"},
+			timedout:    []string{"c1", "c2"},
+			expected:    false,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			cgu := &ranv1alpha1.ClusterGroupUpgrade{
+				Spec: ranv1alpha1.ClusterGroupUpgradeSpec{
+					RemediationStrategy: &ranv1alpha1.RemediationStrategySpec{MaxFailures: tc.maxFailures},
+				},
+				Status: ranv1alpha1.ClusterGroupUpgradeStatus{RemediationPlan: plan},
+			}
+			for _, cluster := range tc.timedout {
+				cgu.Status.Clusters = append(cgu.Status.Clusters,
+					ranv1alpha1.ClusterState{Name: cluster, State: ClusterRemediationTimedout})
+			}
+			cgu.Status.Clusters = append(cgu.Status.Clusters,
+				ranv1alpha1.ClusterState{Name: "c10", State: ClusterRemediationComplete})
+			assert.Equal(t, tc.expected, IsFailureThresholdExceeded(cgu))
+		})
+	}
+}
+
+func TestGetMaxFailures(t *testing.T) {
+	_, err := GetMaxFailures(&intstr.IntOrString{Type: intstr.Int, IntVal: -1}, 10)
+	assert.Error(t, err)
+	_, err = GetMaxFailures(&intstr.IntOrString{Type: intstr.String, StrVal: "ten"}, 10)
+	assert.Error(t, err)
+	value, err := GetMaxFailures(nil, 10)
+	assert.NoError(t, err)
+	assert.Equal(t, -1, value)
+}
+
+func TestWeightedBatchTimeout(t *testing.T) {
+	plan := [][]string{{"c1"}, {"c2", "c3", "c4"}, {"c5", "c6", "c7", "c8", "c9", "c10"}}
+	cguStart := time.Unix(1657000000, 0)
+
+	testcases := []struct {
+		name           string
+		currentBatch   int
+		batchStartTime time.Time
+		expected       time.Duration
+	}{
+		{
+			name:           "First batch",
+			currentBatch:   1,
+			batchStartTime: cguStart,
+			expected:       10 * time.Minute,
+		},
+		{
+			name:           "Middle batch",
+			currentBatch:   2,
+			batchStartTime: cguStart.Add(10 * time.Minute),
+			expected:       30 * time.Minute,
+		},
+		{
+			name:           "Last batch gets all the remaining time",
+			currentBatch:   3,
+			batchStartTime: cguStart.Add(70 * time.Minute),
+			expected:       30 * time.Minute,
+		},
+		{
+			name:           "Out of range batch",
+			currentBatch:   4,
+			batchStartTime: cguStart.Add(90 * time.Minute),
+			expected:       10 * time.Minute,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			actual := CalculateWeightedBatchTimeout(100, plan, tc.currentBatch, tc.batchStartTime, cguStart)
+			assert.Equal(t, tc.expected, actual)
+		})
+	}
+}
+
+func TestSplitIntoBatches(t *testing.T) {
+	clusters := []string{"c1", "c2", "c3", "c4", "c5", "c6", "c7", "c8", "c9", "c10"}
+
+	testcases := []struct {
+		name           string
+		clusters       []string
+		batchSizes     []int
+		maxConcurrency int
+		expected       [][]string
+	}{
+		{
+			name:           "Fixed size batches",
+			clusters:       clusters,
+			maxConcurrency: 4,
+			expected:       [][]string{{"c1", "c2", "c3", "c4"}, {"c5", "c6", "c7", "c8"}, {"c9", "c10"}},
+		},
+		{
+			name:           "Progressive batches then maxConcurrency",
+			clusters:       clusters,
+			batchSizes:     []int{1, 2, 3},
+			maxConcurrency: 3,
+			expected:       [][]string{{"c1"}, {"c2", "c3"}, {"c4", "c5", "c6"}, {"c7", "c8", "c9"}, {"c10"}},
+		},
+		{
+			name:           "Progressive batches larger than the cluster list",
+			clusters:       clusters[:4],
+			batchSizes:     []int{1, 5, 10},
+			maxConcurrency: 10,
+			expected:       [][]string{{"c1"}, {"c2", "c3", "c4"}},
+		},
+		{
+			name:           "No maxConcurrency",
+			clusters:       clusters[:3],
+			maxConcurrency: 0,
+			expected:       [][]string{{"c1", "c2", "c3"}},
+		},
+		{
+			name:           "No clusters",
+			batchSizes:     []int{1},
+			maxConcurrency: 1,
+			expected:       nil,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, SplitIntoBatches(tc.clusters, tc.batchSizes, tc.maxConcurrency))
+		})
+	}
+}
+
+func TestGetBatchSizes(t *testing.T) {
+	sizes, err := GetBatchSizes([]intstr.IntOrString{
+		intstr.FromInt32(1), intstr.FromInt32(5), intstr.FromString("25%"), intstr.FromString("50%")}, 10)
+	assert.NoError(t, err)
+	assert.Equal(t, []int{1, 5, 3, 5}, sizes)
+
+	_, err = GetBatchSizes([]intstr.IntOrString{intstr.FromInt32(0)}, 10)
+	assert.Error(t, err)
+	_, err = GetBatchSizes([]intstr.IntOrString{intstr.FromString("abc")}, 10)
+	assert.Error(t, err)
+}
+
+func TestGetMaxConcurrency(t *testing.T) {
+	testcases := []struct {
+		name                     string
+		maxConcurrency           int
+		maxConcurrencyPercentage int
+		expected                 int
+	}{
+		{name: "Number", maxConcurrency: 3, expected: 3},
+		{name: "Number larger than the clusters", maxConcurrency: 30, expected: 10},
+		{name: "Zero", maxConcurrency: 0, expected: 10},
+		{name: "Percentage", maxConcurrencyPercentage: 25, expected: 3},
+		{name: "Percentage takes precedence", maxConcurrency: 1, maxConcurrencyPercentage: 50, expected: 5},
+		{name: "Full percentage", maxConcurrencyPercentage: 100, expected: 10},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, GetMaxConcurrency(tc.maxConcurrency, tc.maxConcurrencyPercentage, 10))
+		})
+	}
+}
+
+func TestSpreadIntoBatches(t *testing.T) {
+	clusters := []string{"a1", "a2", "a3", "b1", "b2", "c1", "x1"}
+	domains := map[string]string{"a1": "a", "a2": "a", "a3": "a", "b1": "b", "b2": "b", "c1": "c"}
+
+	testcases := []struct {
+		name           string
+		maxPerBatch    int
+		batchSizes     []int
+		maxConcurrency int
+		expected       [][]string
+	}{
+		{
+			name:           "One cluster per domain",
+			maxPerBatch:    1,
+			maxConcurrency: 3,
+			expected:       [][]string{{"a1", "b1", "c1"}, {"a2", "b2", "x1"}, {"a3"}},
+		},
+		{
+			name:           "Two clusters per domain",
+			maxPerBatch:    2,
+			maxConcurrency: 4,
+			expected:       [][]string{{"a1", "a2", "b1", "b2"}, {"a3", "c1", "x1"}},
+		},
+		{
+			name:           "With progressive batches",
+			maxPerBatch:    1,
+			batchSizes:     []int{1},
+			maxConcurrency: 4,
+			expected:       [][]string{{"a1"}, {"a2", "b1", "c1", "x1"}, {"a3", "b2"}},
+		},
+		{
+			name:           "Invalid maxPerBatch defaults to one",
+			maxPerBatch:    0,
+			maxConcurrency: 10,
+			expected:       [][]string{{"a1", "b1", "c1", "x1"}, {"a2", "b2"}, {"a3"}},
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected,
+				SpreadIntoBatches(clusters, domains, tc.maxPerBatch, tc.batchSizes, tc.maxConcurrency))
+		})
+	}
+}
+
+func TestGroupIntoBatches(t *testing.T) {
+	clusters := []string{"a1", "b1", "x1", "a2", "c1", "b2", "a3", "x2", "d1"}
+	domains := map[string]string{"a1": "a", "a2": "a", "a3": "a", "b1": "b", "b2": "b", "c1": "c", "d1": "d"}
+
+	testcases := []struct {
+		name           string
+		batchSizes     []int
+		maxConcurrency int
+		expected       [][]string
+	}{
+		{
+			name:           "Domains packed up to maxConcurrency",
+			maxConcurrency: 3,
+			expected:       [][]string{{"a1", "a2", "a3"}, {"b1", "b2", "c1"}, {"d1"}, {"x1", "x2"}},
+		},
+		{
+			name:           "Domain bigger than the batch size",
+			maxConcurrency: 2,
+			expected:       [][]string{{"a1", "a2", "a3"}, {"b1", "b2"}, {"c1", "d1"}, {"x1", "x2"}},
+		},
+		{
+			name:           "With progressive batches",
+			batchSizes:     []int{1, 1, 1, 1, 1},
+			maxConcurrency: 10,
+			expected:       [][]string{{"a1", "a2", "a3"}, {"b1", "b2"}, {"c1"}, {"d1"}, {"x1"}, {"x2"}},
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, GroupIntoBatches(clusters, domains, tc.batchSizes, tc.maxConcurrency))
+		})
+	}
+}
+
+func TestRemoveFromRemediationPlan(t *testing.T) {
+	plan := [][]string{{"c1"}, {"c2", "c3"}, {"c4"}, {"c5", "c6"}}
+	removed := map[string]bool{"c1": true, "c2": true, "c3": true, "c4": true, "c6": true}
+
+	assert.Equal(t, [][]string{{"c1"}, {}, {"c5"}}, RemoveFromRemediationPlan(plan, 1, removed))
+	assert.Equal(t, [][]string{{"c1"}, {"c2", "c3"}, {}, {"c5"}}, RemoveFromRemediationPlan(plan, 2, map[string]bool{"c4": true, "c6": true}))
+}
+
+func TestAppendToRemediationPlan(t *testing.T) {
+	testcases := []struct {
+		name          string
+		plan          [][]string
+		fillFromIndex int
+		clusters      []string
+		expected      [][]string
+	}{
+		{
+			name:          "Last batch filled first",
+			plan:          [][]string{{"c1", "c2"}, {"c3"}},
+			fillFromIndex: 1,
+			clusters:      []string{"n1", "n2", "n3", "n4"},
+			expected:      [][]string{{"c1", "c2"}, {"c3", "n1"}, {"n2", "n3"}, {"n4"}},
+		},
+		{
+			name:          "Last batch not fillable",
+			plan:          [][]string{{"c1", "c2"}, {"c3"}},
+			fillFromIndex: 2,
+			clusters:      []string{"n1", "n2", "n3"},
+			expected:      [][]string{{"c1", "c2"}, {"c3"}, {"n1", "n2"}, {"n3"}},
+		},
+		{
+			name:          "Empty plan",
+			fillFromIndex: 0,
+			clusters:      []string{"n1"},
+			expected:      [][]string{{"n1"}},
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, AppendToRemediationPlan(tc.plan, tc.fillFromIndex, tc.clusters, 2))
+		})
+	}
+}
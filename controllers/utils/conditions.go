@@ -17,6 +17,7 @@ var ConditionTypes = struct {
 	PrecacheSpecValid  ConditionType
 	PrecachingSuceeded ConditionType
 	Progressing        ConditionType
+	RolledBack         ConditionType
 	Succeeded          ConditionType
 	Validated          ConditionType
 }{
@@ -25,6 +26,7 @@ var ConditionTypes = struct {
 	PrecacheSpecValid:  "PrecacheSpecValid",
 	PrecachingSuceeded: "PrecachingSuceeded",
 	Progressing:        "Progressing",
+	RolledBack:         "RolledBack",
 	Succeeded:          "Succeeded",
 	Validated:          "Validated",
 }
@@ -35,11 +37,14 @@ type ConditionReason string
 // ConditionReasons define the different reasons that conditions will be set for
 var ConditionReasons = struct {
 	Completed                     ConditionReason
+	CanaryFailed                  ConditionReason
 	ClusterSelectionCompleted     ConditionReason
 	ValidationCompleted           ConditionReason
 	BackupCompleted               ConditionReason
 	PrecachingCompleted           ConditionReason
+	DryRun                        ConditionReason
 	Failed                        ConditionReason
+	FailureThresholdExceeded      ConditionReason
 	IncompleteBlockingCR          ConditionReason
 	InProgress                    ConditionReason
 	InvalidPlatformImage          ConditionReason
@@ -52,18 +57,27 @@ var ConditionReasons = struct {
 	ClusterNotFound               ConditionReason
 	NotPresent                    ConditionReason
 	PartiallyDone                 ConditionReason
+	Paused                        ConditionReason
 	PrecacheSpecIncomplete        ConditionReason
 	PrecacheSpecIsWellFormed      ConditionReason
+	RollingBack                   ConditionReason
+	Scheduled                     ConditionReason
 	TimedOut                      ConditionReason
 	UnresolvableDenpendency       ConditionReason
 	WaitingForPlacement           ConditionReason
+	WaitingForMaintenanceWindow   ConditionReason
+	WaitingForConcurrencySlot     ConditionReason
+	WaitingForConflictingCR       ConditionReason
 }{
 	Completed:                     "Completed",
+	CanaryFailed:                  "CanaryFailed",
 	ClusterSelectionCompleted:     "ClusterSelectionCompleted",
 	ValidationCompleted:           "ValidationCompleted",
 	BackupCompleted:               "BackupCompleted",
 	PrecachingCompleted:           "PrecachingCompleted",
+	DryRun:                        "DryRun",
 	Failed:                        "Failed",
+	FailureThresholdExceeded:      "FailureThresholdExceeded",
 	IncompleteBlockingCR:          "IncompleteBlockingCR",
 	InProgress:                    "InProgress",
 	InvalidPlatformImage:          "InvalidPlatformImage",
@@ -76,11 +90,17 @@ var ConditionReasons = struct {
 	ClusterNotFound:               "ClusterNotFound",
 	NotPresent:                    "NotPresent",
 	PartiallyDone:                 "PartiallyDone",
+	Paused:                        "Paused",
 	PrecacheSpecIncomplete:        "PrecacheSpecIncomplete",
 	PrecacheSpecIsWellFormed:      "PrecacheSpecIsWellFormed",
+	RollingBack:                   "RollingBack",
+	Scheduled:                     "Scheduled",
 	TimedOut:                      "TimedOut",
 	UnresolvableDenpendency:       "UnresolvableDenpendency",
 	WaitingForPlacement:           "WaitingForPlacement",
+	WaitingForMaintenanceWindow:   "WaitingForMaintenanceWindow",
+	WaitingForConcurrencySlot:     "WaitingForConcurrencySlot",
+	WaitingForConflictingCR:       "WaitingForConflictingCR",
 }
 
 // InProgressMessages defines the in progress messages for the conditions by rollout type
@@ -6,6 +6,8 @@ import "k8s.io/apimachinery/pkg/runtime/schema"
 const (
 	CGUControllerWorkerCountEnv     = "TALM_CGU_CTRL_WORKER_COUNT"
 	DefaultCGUControllerWorkerCount = 5
+	// DefaultCGUTimeoutMinutes matches the default of remediationStrategy.timeout in the CRD
+	DefaultCGUTimeoutMinutes = 240
 )
 
 // RemediationActionEnforce - Policy remediation for policies.
@@ -25,8 +27,11 @@ const (
 
 // Possible status of cluster remediation progress
 const (
-	ClusterRemediationComplete = "complete"
-	ClusterRemediationTimedout = "timedout"
+	ClusterRemediationComplete        = "complete"
+	ClusterRemediationTimedout        = "timedout"
+	ClusterRemediationRemoved         = "removed"
+	ClusterRemediationUnavailable     = "unavailable"
+	ClusterRemediationPreflightFailed = "preflightfailed"
 )
 
 // Label specific to ACM child policies.
@@ -97,6 +102,9 @@ const (
 	SubscriptionStateUpgradePending = "UpgradePending"
 )
 
+// ManagedClusterLeaseName is the name of the lease renewed by the klusterlet agent in the cluster namespace on the hub
+const ManagedClusterLeaseName = "managed-cluster-lease"
+
 // Multicloud object types
 const (
 	ManagedClusterViewPrefix   = "view"
@@ -172,6 +180,10 @@ const SpaceRequiredForPrecache = "35 GiB"
 // which policies should be compliant before the cgu moves on from that policy
 const SoakAnnotation = "ran.openshift.io/soak-seconds"
 
+// MaintenanceWindowsAnnotation is the annotation that can be set on a ManagedCluster with a JSON list of
+// maintenance windows, outside of which the remediation of the cluster does not start
+const MaintenanceWindowsAnnotation = "ran.openshift.io/maintenance-windows"
+
 // BlockingCGUCompletionModeAnn is an annotation that can be set on CGU, in order to continue with dependent CGU even if
 // the original CR is only partially completed
 const (
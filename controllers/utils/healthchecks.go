@@ -0,0 +1,213 @@
+package utils
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	ranv1alpha1 "github.com/openshift-kni/cluster-group-upgrades-operator/pkg/api/clustergroupupgrades/v1alpha1"
+	viewv1beta1 "github.com/stolostron/cluster-lifecycle-api/view/v1beta1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// Health check results
+const (
+	HealthCheckPending = iota
+	HealthCheckPassed
+	HealthCheckFailed
+)
+
+// Health check kinds, used to name the views of the checks
+const (
+	PreflightCheckKind    = "preflight"
+	VerificationCheckKind = "verification"
+)
+
+// HealthCheck is a resource of a managed cluster viewed through a ManagedClusterView. Evaluate returns why the
+// resource is not healthy, or an empty string if it is.
+type HealthCheck struct {
+	Name              string
+	Resource          string
+	ResourceName      string
+	ResourceNamespace string
+	Evaluate          func(object *unstructured.Unstructured) string
+}
+
+// conditionExpectation is the status a condition must have. A missing condition only meets a False expectation.
+type conditionExpectation struct {
+	conditionType string
+	status        metav1.ConditionStatus
+}
+
+// expectConditions returns a function evaluating the status conditions of a resource
+func expectConditions(expectations ...conditionExpectation) func(object *unstructured.Unstructured) string {
+	return func(object *unstructured.Unstructured) string {
+		conditions, _, _ := unstructured.NestedSlice(object.Object, "status", "conditions")
+		for _, expectation := range expectations {
+			status, message := metav1.ConditionFalse, ""
+			for _, c := range conditions {
+				condition, ok := c.(map[string]interface{})
+				if !ok || condition["type"] != expectation.conditionType {
+					continue
+				}
+				status = metav1.ConditionStatus(fmt.Sprint(condition["status"]))
+				message, _ = condition["message"].(string)
+				break
+			}
+			if status != expectation.status {
+				reason := fmt.Sprintf("%s %s condition %s is %s", object.GetKind(), object.GetName(), expectation.conditionType, status)
+				if message != "" {
+					reason += ": " + message
+				}
+				return reason
+			}
+		}
+		return ""
+	}
+}
+
+// expectMachinesReady checks that all the machines of a MachineConfigPool are ready
+func expectMachinesReady(object *unstructured.Unstructured) string {
+	machineCount, _, _ := unstructured.NestedInt64(object.Object, "status", "machineCount")
+	readyMachineCount, _, _ := unstructured.NestedInt64(object.Object, "status", "readyMachineCount")
+	if readyMachineCount < machineCount {
+		return fmt.Sprintf("%s %s has %d of %d machines ready", object.GetKind(), object.GetName(), readyMachineCount, machineCount)
+	}
+	return ""
+}
+
+// GetHealthChecks returns the health checks enabled in a HealthChecksSpec
+func GetHealthChecks(spec *ranv1alpha1.HealthChecksSpec) []HealthCheck {
+	if spec == nil {
+		return nil
+	}
+
+	var checks []HealthCheck
+	if spec.ClusterVersion {
+		checks = append(checks, HealthCheck{
+			Name:         "clusterversion",
+			Resource:     "ClusterVersion.config.openshift.io",
+			ResourceName: "version",
+			Evaluate:     expectConditions(conditionExpectation{"Progressing", metav1.ConditionFalse}),
+		})
+	}
+	if spec.ClusterVersionAvailable {
+		checks = append(checks, HealthCheck{
+			Name:         "clusterversion-available",
+			Resource:     "ClusterVersion.config.openshift.io",
+			ResourceName: "version",
+			Evaluate: expectConditions(
+				conditionExpectation{"Available", metav1.ConditionTrue},
+				conditionExpectation{"Failing", metav1.ConditionFalse}),
+		})
+	}
+	if spec.Etcd {
+		checks = append(checks, HealthCheck{
+			Name:         "etcd",
+			Resource:     "ClusterOperator.config.openshift.io",
+			ResourceName: "etcd",
+			Evaluate: expectConditions(
+				conditionExpectation{"Available", metav1.ConditionTrue},
+				conditionExpectation{"Degraded", metav1.ConditionFalse}),
+		})
+	}
+
+	poolNames := spec.MachineConfigPoolNames
+	if len(poolNames) == 0 {
+		poolNames = []string{"master", "worker"}
+	}
+	for _, poolName := range poolNames {
+		var evaluations []func(object *unstructured.Unstructured) string
+		if spec.MachineConfigPools {
+			evaluations = append(evaluations, expectConditions(
+				conditionExpectation{"Updating", metav1.ConditionFalse},
+				conditionExpectation{"Degraded", metav1.ConditionFalse}))
+		}
+		if spec.Nodes {
+			evaluations = append(evaluations, expectMachinesReady)
+		}
+		if len(evaluations) == 0 {
+			break
+		}
+		checks = append(checks, HealthCheck{
+			Name:         "mcp-" + poolName,
+			Resource:     "MachineConfigPool.machineconfiguration.openshift.io",
+			ResourceName: poolName,
+			Evaluate: func(object *unstructured.Unstructured) string {
+				for _, evaluate := range evaluations {
+					if reason := evaluate(object); reason != "" {
+						return reason
+					}
+				}
+				return ""
+			},
+		})
+	}
+
+	for _, resource := range spec.Resources {
+		var expectations []conditionExpectation
+		for _, condition := range resource.Conditions {
+			expectations = append(expectations, conditionExpectation{condition.Type, condition.Status})
+		}
+		checks = append(checks, HealthCheck{
+			Name:              strings.Join([]string{"resource", resource.Resource, resource.Namespace, resource.Name}, "-"),
+			Resource:          resource.Resource,
+			ResourceName:      resource.Name,
+			ResourceNamespace: resource.Namespace,
+			Evaluate:          expectConditions(expectations...),
+		})
+	}
+	return checks
+}
+
+// RunHealthChecks ensures the views of the health checks of a cluster exist and evaluates the resources they retrieved.
+// It returns HealthCheckFailed and the reason as soon as a check fails, HealthCheckPending while some views have not
+// retrieved their resource yet and HealthCheckPassed once all the checks passed.
+func RunHealthChecks(
+	ctx context.Context, c client.Client, clusterGroupUpgrade *ranv1alpha1.ClusterGroupUpgrade,
+	clusterName, kind string, checks []HealthCheck) (int, string, error) {
+
+	result := HealthCheckPassed
+	for _, check := range checks {
+		mcvName := GetMultiCloudObjectName(clusterGroupUpgrade, kind, check.Name)
+		safeName := GetSafeResourceName(mcvName, "", clusterGroupUpgrade, MaxObjectNameLength)
+		mcv, err := EnsureManagedClusterView(
+			ctx, c, safeName, mcvName, clusterName, check.Resource, check.ResourceName, check.ResourceNamespace,
+			clusterGroupUpgrade.Name, clusterGroupUpgrade.Namespace)
+		if err != nil {
+			return HealthCheckPending, "", err
+		}
+
+		condition := meta.FindStatusCondition(mcv.Status.Conditions, viewv1beta1.ConditionViewProcessing)
+		if condition == nil {
+			multiCloudLog.Info("ManagedClusterView was not (yet) ready, try again later",
+				"managedclusterview", mcv.Name, "namespace", mcv.Namespace)
+			result = HealthCheckPending
+			continue
+		}
+		if condition.Reason == viewv1beta1.ReasonGetResourceFailed {
+			return HealthCheckFailed, fmt.Sprintf("%s %s could not be retrieved: %s",
+				check.Resource, check.ResourceName, condition.Message), nil
+		}
+		if condition.Status != metav1.ConditionTrue || condition.Reason != viewv1beta1.ReasonGetResource {
+			multiCloudLog.Info("ManagedClusterView was not able to retrieve the requested resource (yet), trying again later",
+				"managedclusterview", mcv.Name, "namespace", mcv.Namespace)
+			result = HealthCheckPending
+			continue
+		}
+
+		object := &unstructured.Unstructured{}
+		if err := object.UnmarshalJSON(mcv.Status.Result.Raw); err != nil {
+			multiCloudLog.Info("Unable to parse result from MCV status", "raw result", mcv.Status.Result.Raw, "err", err)
+			result = HealthCheckPending
+			continue
+		}
+		if reason := check.Evaluate(object); reason != "" {
+			return HealthCheckFailed, reason, nil
+		}
+	}
+	return result, "", nil
+}
@@ -0,0 +1,149 @@
+package utils
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	ranv1alpha1 "github.com/openshift-kni/cluster-group-upgrades-operator/pkg/api/clustergroupupgrades/v1alpha1"
+	viewv1beta1 "github.com/stolostron/cluster-lifecycle-api/view/v1beta1"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func newHealthCheckView(t *testing.T, cgu *ranv1alpha1.ClusterGroupUpgrade, checkName string, result map[string]interface{}) client.Object {
+	raw, err := json.Marshal(result)
+	assert.NoError(t, err)
+	mcvName := GetMultiCloudObjectName(cgu, PreflightCheckKind, checkName)
+	return &viewv1beta1.ManagedClusterView{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      GetSafeResourceName(mcvName, "", cgu, MaxObjectNameLength),
+			Namespace: "spoke1",
+		},
+		Status: viewv1beta1.ViewStatus{
+			Conditions: []metav1.Condition{{
+				Type:   viewv1beta1.ConditionViewProcessing,
+				Status: metav1.ConditionTrue,
+				Reason: viewv1beta1.ReasonGetResource,
+			}},
+			Result: runtime.RawExtension{Raw: raw},
+		},
+	}
+}
+
+func newHealthCheckResource(kind, name string, readyMachineCount int, conditions ...map[string]interface{}) map[string]interface{} {
+	var conditionList []interface{}
+	for _, condition := range conditions {
+		conditionList = append(conditionList, condition)
+	}
+	return map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       kind,
+		"metadata":   map[string]interface{}{"name": name},
+		"status": map[string]interface{}{
+			"conditions":        conditionList,
+			"machineCount":      3,
+			"readyMachineCount": readyMachineCount,
+		},
+	}
+}
+
+func TestRunHealthChecks(t *testing.T) {
+	spec := &ranv1alpha1.HealthChecksSpec{
+		ClusterVersionAvailable: true, Etcd: true, MachineConfigPools: true, Nodes: true, MachineConfigPoolNames: []string{"master"}}
+	available := map[string]interface{}{"type": "Available", "status": "True"}
+	degraded := map[string]interface{}{"type": "Degraded", "status": "True", "message": "EtcdMembersDegraded"}
+
+	testcases := []struct {
+		name           string
+		resources      map[string]map[string]interface{}
+		expectedResult int
+		expectedReason string
+	}{
+		{
+			name:           "views not ready",
+			expectedResult: HealthCheckPending,
+		},
+		{
+			name: "all checks pass",
+			resources: map[string]map[string]interface{}{
+				"clusterversion-available": newHealthCheckResource("ClusterVersion", "version", 3, available),
+				"etcd":                     newHealthCheckResource("ClusterOperator", "etcd", 3, available),
+				"mcp-master":               newHealthCheckResource("MachineConfigPool", "master", 3),
+			},
+			expectedResult: HealthCheckPassed,
+		},
+		{
+			name: "etcd degraded",
+			resources: map[string]map[string]interface{}{
+				"clusterversion-available": newHealthCheckResource("ClusterVersion", "version", 3, available),
+				"etcd":                     newHealthCheckResource("ClusterOperator", "etcd", 3, available, degraded),
+			},
+			expectedResult: HealthCheckFailed,
+			expectedReason: "ClusterOperator etcd condition Degraded is True: EtcdMembersDegraded",
+		},
+		{
+			name: "nodes not ready",
+			resources: map[string]map[string]interface{}{
+				"clusterversion-available": newHealthCheckResource("ClusterVersion", "version", 3, available),
+				"etcd":                     newHealthCheckResource("ClusterOperator", "etcd", 3, available),
+				"mcp-master":               newHealthCheckResource("MachineConfigPool", "master", 2),
+			},
+			expectedResult: HealthCheckFailed,
+			expectedReason: "MachineConfigPool master has 2 of 3 machines ready",
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			cgu := &ranv1alpha1.ClusterGroupUpgrade{ObjectMeta: metav1.ObjectMeta{Name: "cgu", Namespace: "default"}}
+			var objs []client.Object
+			for checkName, resource := range tc.resources {
+				objs = append(objs, newHealthCheckView(t, cgu, checkName, resource))
+			}
+			fakeClient, _ := getFakeClientFromObjects(objs...)
+
+			result, reason, err := RunHealthChecks(context.TODO(), fakeClient, cgu, "spoke1", PreflightCheckKind, GetHealthChecks(spec))
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedResult, result)
+			assert.Equal(t, tc.expectedReason, reason)
+
+			views := &viewv1beta1.ManagedClusterViewList{}
+			assert.NoError(t, fakeClient.List(context.TODO(), views, client.InNamespace("spoke1")))
+			assert.NotEmpty(t, views.Items)
+		})
+	}
+}
+
+func TestGetHealthChecksVerification(t *testing.T) {
+	spec := &ranv1alpha1.HealthChecksSpec{
+		ClusterVersion: true,
+		Resources: []ranv1alpha1.ResourceConditionCheck{{
+			Resource:  "Deployment.apps",
+			Name:      "app",
+			Namespace: "app-ns",
+			Conditions: []ranv1alpha1.ExpectedCondition{
+				{Type: "Available", Status: metav1.ConditionTrue},
+				{Type: "ReplicaFailure", Status: metav1.ConditionFalse},
+			},
+		}},
+	}
+	checks := GetHealthChecks(spec)
+	assert.Len(t, checks, 2)
+	assert.Equal(t, "clusterversion", checks[0].Name)
+	assert.Equal(t, "resource-Deployment.apps-app-ns-app", checks[1].Name)
+	assert.Equal(t, "app-ns", checks[1].ResourceNamespace)
+
+	progressing := map[string]interface{}{"type": "Progressing", "status": "True", "message": "Working towards 4.16.3"}
+	clusterVersion := &unstructured.Unstructured{Object: newHealthCheckResource("ClusterVersion", "version", 3, progressing)}
+	assert.Equal(t, "ClusterVersion version condition Progressing is True: Working towards 4.16.3", checks[0].Evaluate(clusterVersion))
+
+	available := map[string]interface{}{"type": "Available", "status": "True"}
+	deployment := &unstructured.Unstructured{Object: newHealthCheckResource("Deployment", "app", 3, available)}
+	assert.Equal(t, "", checks[1].Evaluate(deployment))
+	deployment = &unstructured.Unstructured{Object: newHealthCheckResource("Deployment", "app", 3)}
+	assert.Equal(t, "Deployment app condition Available is False", checks[1].Evaluate(deployment))
+}
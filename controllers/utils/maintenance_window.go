@@ -0,0 +1,102 @@
+package utils
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"time"
+
+	ranv1alpha1 "github.com/openshift-kni/cluster-group-upgrades-operator/pkg/api/clustergroupupgrades/v1alpha1"
+)
+
+// maxMaintenanceWindowDuration keeps the windows shorter than a week so that they don't overlap with themselves
+const maxMaintenanceWindowDuration = 7 * 24 * time.Hour
+
+var weekdays = map[string]time.Weekday{
+	"Sun": time.Sunday,
+	"Mon": time.Monday,
+	"Tue": time.Tuesday,
+	"Wed": time.Wednesday,
+	"Thu": time.Thursday,
+	"Fri": time.Friday,
+	"Sat": time.Saturday,
+}
+
+// ValidateMaintenanceWindows checks that the maintenance windows are well formed
+func ValidateMaintenanceWindows(windows []ranv1alpha1.MaintenanceWindow) error {
+	for i, window := range windows {
+		if _, err := time.Parse("15:04", window.StartTime); err != nil {
+			return fmt.Errorf("maintenance window %d: invalid startTime %q, expected HH:MM", i, window.StartTime)
+		}
+		if window.Duration.Duration <= 0 || window.Duration.Duration > maxMaintenanceWindowDuration {
+			return fmt.Errorf("maintenance window %d: duration must be positive and at most %s", i, maxMaintenanceWindowDuration)
+		}
+		if _, err := time.LoadLocation(window.TimeZone); err != nil {
+			return fmt.Errorf("maintenance window %d: invalid timeZone %q: %w", i, window.TimeZone, err)
+		}
+		for _, day := range window.DaysOfWeek {
+			if _, ok := weekdays[day]; !ok {
+				return fmt.Errorf("maintenance window %d: invalid day of the week %q", i, day)
+			}
+		}
+	}
+	return nil
+}
+
+// ParseMaintenanceWindows parses the maintenance windows set in the ManagedCluster annotation
+func ParseMaintenanceWindows(annotation string) ([]ranv1alpha1.MaintenanceWindow, error) {
+	var windows []ranv1alpha1.MaintenanceWindow
+	if err := json.Unmarshal([]byte(annotation), &windows); err != nil {
+		return nil, fmt.Errorf("invalid %s annotation: %w", MaintenanceWindowsAnnotation, err)
+	}
+	if err := ValidateMaintenanceWindows(windows); err != nil {
+		return nil, fmt.Errorf("invalid %s annotation: %w", MaintenanceWindowsAnnotation, err)
+	}
+	return windows, nil
+}
+
+// IsInMaintenanceWindow returns whether the given time is inside any of the maintenance windows, and if not,
+// when the next window opens. It always returns true if there are no windows. The windows must be valid.
+func IsInMaintenanceWindow(windows []ranv1alpha1.MaintenanceWindow, now time.Time) (bool, time.Time) {
+	if len(windows) == 0 {
+		return true, time.Time{}
+	}
+
+	var nextOpening time.Time
+	for _, window := range windows {
+		location, err := time.LoadLocation(window.TimeZone)
+		if err != nil {
+			continue
+		}
+		startTime, err := time.Parse("15:04", window.StartTime)
+		if err != nil {
+			continue
+		}
+
+		localNow := now.In(location)
+		// Look at the openings of the window from a week ago, which may still be open, up to a week ahead
+		for dayOffset := -7; dayOffset <= 7; dayOffset++ {
+			day := localNow.AddDate(0, 0, dayOffset)
+			opening := time.Date(day.Year(), day.Month(), day.Day(), startTime.Hour(), startTime.Minute(), 0, 0, location)
+			if !isMaintenanceWindowDay(window, opening.Weekday()) {
+				continue
+			}
+			if !now.Before(opening) && now.Before(opening.Add(window.Duration.Duration)) {
+				return true, time.Time{}
+			}
+			if opening.After(now) && (nextOpening.IsZero() || opening.Before(nextOpening)) {
+				nextOpening = opening
+			}
+		}
+	}
+	return false, nextOpening
+}
+
+func isMaintenanceWindowDay(window ranv1alpha1.MaintenanceWindow, weekday time.Weekday) bool {
+	if len(window.DaysOfWeek) == 0 {
+		return true
+	}
+	return slices.ContainsFunc(window.DaysOfWeek, func(day string) bool {
+		return weekdays[day] == weekday
+	})
+}
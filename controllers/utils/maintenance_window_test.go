@@ -0,0 +1,117 @@
+package utils
+
+import (
+	"testing"
+	"time"
+
+	ranv1alpha1 "github.com/openshift-kni/cluster-group-upgrades-operator/pkg/api/clustergroupupgrades/v1alpha1"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestIsInMaintenanceWindow(t *testing.T) {
+	// Saturday 2024-06-01 02:30 UTC
+	saturday := time.Date(2024, time.June, 1, 2, 30, 0, 0, time.UTC)
+
+	testcases := []struct {
+		name                string
+		windows             []ranv1alpha1.MaintenanceWindow
+		now                 time.Time
+		expectedInWindow    bool
+		expectedNextOpening time.Time
+	}{
+		{
+			name:             "No windows",
+			now:              saturday,
+			expectedInWindow: true,
+		},
+		{
+			name: "Inside a daily window",
+			windows: []ranv1alpha1.MaintenanceWindow{
+				{StartTime: "01:00", Duration: metav1.Duration{Duration: 4 * time.Hour}},
+			},
+			now:              saturday,
+			expectedInWindow: true,
+		},
+		{
+			name: "Before a daily window",
+			windows: []ranv1alpha1.MaintenanceWindow{
+				{StartTime: "03:00", Duration: metav1.Duration{Duration: time.Hour}},
+			},
+			now:                 saturday,
+			expectedNextOpening: time.Date(2024, time.June, 1, 3, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "Window opened the day before and spanning midnight",
+			windows: []ranv1alpha1.MaintenanceWindow{
+				{DaysOfWeek: []string{"Fri"}, StartTime: "22:00", Duration: metav1.Duration{Duration: 6 * time.Hour}},
+			},
+			now:              saturday,
+			expectedInWindow: true,
+		},
+		{
+			name: "Next opening on another day",
+			windows: []ranv1alpha1.MaintenanceWindow{
+				{DaysOfWeek: []string{"Tue", "Thu"}, StartTime: "01:00", Duration: metav1.Duration{Duration: time.Hour}},
+			},
+			now:                 saturday,
+			expectedNextOpening: time.Date(2024, time.June, 4, 1, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "Window in another time zone",
+			windows: []ranv1alpha1.MaintenanceWindow{
+				{StartTime: "04:00", Duration: metav1.Duration{Duration: time.Hour}, TimeZone: "Europe/Madrid"},
+			},
+			now:              saturday,
+			expectedInWindow: true,
+		},
+		{
+			name: "Earliest opening of several windows",
+			windows: []ranv1alpha1.MaintenanceWindow{
+				{DaysOfWeek: []string{"Mon"}, StartTime: "00:00", Duration: metav1.Duration{Duration: time.Hour}},
+				{DaysOfWeek: []string{"Sun"}, StartTime: "23:00", Duration: metav1.Duration{Duration: time.Hour}},
+			},
+			now:                 saturday,
+			expectedNextOpening: time.Date(2024, time.June, 2, 23, 0, 0, 0, time.UTC),
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			inWindow, nextOpening := IsInMaintenanceWindow(tc.windows, tc.now)
+			assert.Equal(t, tc.expectedInWindow, inWindow)
+			assert.True(t, tc.expectedNextOpening.Equal(nextOpening), "expected %s, got %s", tc.expectedNextOpening, nextOpening)
+		})
+	}
+}
+
+func TestValidateMaintenanceWindows(t *testing.T) {
+	valid := ranv1alpha1.MaintenanceWindow{
+		DaysOfWeek: []string{"Sat", "Sun"}, StartTime: "22:30", Duration: metav1.Duration{Duration: 2 * time.Hour}, TimeZone: "America/New_York"}
+	assert.NoError(t, ValidateMaintenanceWindows([]ranv1alpha1.MaintenanceWindow{valid}))
+
+	invalidStartTime := valid
+	invalidStartTime.StartTime = "25:00"
+	invalidDuration := valid
+	invalidDuration.Duration = metav1.Duration{}
+	invalidTimeZone := valid
+	invalidTimeZone.TimeZone = "Mars/Olympus_Mons"
+	invalidDay := valid
+	invalidDay.DaysOfWeek = []string{"Saturday"}
+	for _, window := range []ranv1alpha1.MaintenanceWindow{invalidStartTime, invalidDuration, invalidTimeZone, invalidDay} {
+		assert.Error(t, ValidateMaintenanceWindows([]ranv1alpha1.MaintenanceWindow{window}))
+	}
+}
+
+func TestParseMaintenanceWindows(t *testing.T) {
+	windows, err := ParseMaintenanceWindows(`[{"daysOfWeek":["Mon"],"startTime":"01:00","duration":"3h","timeZone":"Asia/Tokyo"}]`)
+	assert.NoError(t, err)
+	assert.Equal(t, []ranv1alpha1.MaintenanceWindow{
+		{DaysOfWeek: []string{"Mon"}, StartTime: "01:00", Duration: metav1.Duration{Duration: 3 * time.Hour}, TimeZone: "Asia/Tokyo"},
+	}, windows)
+
+	_, err = ParseMaintenanceWindows(`not json`)
+	assert.Error(t, err)
+	_, err = ParseMaintenanceWindows(`[{"startTime":"1am","duration":"3h"}]`)
+	assert.Error(t, err)
+}
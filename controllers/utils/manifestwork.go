@@ -162,19 +162,27 @@ func CleanupManifestWorkForBatch(ctx context.Context, c client.Client, clusterGr
 	if clusterGroupUpgrade.RolloutType() != ranv1alpha1.RolloutTypes.ManifestWork {
 		return nil
 	}
+	for _, clusterName := range clusterGroupUpgrade.Status.RemediationPlan[batchIndex] {
+		if err := CleanupManifestWorkForCluster(ctx, c, clusterGroupUpgrade, clusterName); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// CleanupManifestWorkForCluster deletes the manifestwork instances of the CGU for the given spoke
+func CleanupManifestWorkForCluster(ctx context.Context, c client.Client, clusterGroupUpgrade *ranv1alpha1.ClusterGroupUpgrade, clusterName string) error {
 	var labels = map[string]string{
 		"openshift-cluster-group-upgrades/clusterGroupUpgrade":          clusterGroupUpgrade.Name,
 		"openshift-cluster-group-upgrades/clusterGroupUpgradeNamespace": clusterGroupUpgrade.Namespace}
-	for _, clusterName := range clusterGroupUpgrade.Status.RemediationPlan[batchIndex] {
 
-		deleteAllOpts := []client.DeleteAllOfOption{
-			client.InNamespace(clusterName),
-			client.MatchingLabels(labels),
-		}
+	deleteAllOpts := []client.DeleteAllOfOption{
+		client.InNamespace(clusterName),
+		client.MatchingLabels(labels),
+	}
 
-		if err := c.DeleteAllOf(ctx, &mwv1.ManifestWork{}, deleteAllOpts...); client.IgnoreNotFound(err) != nil {
-			return fmt.Errorf("failed to delete manifestwork for cluster %s due to err %v", clusterName, err)
-		}
+	if err := c.DeleteAllOf(ctx, &mwv1.ManifestWork{}, deleteAllOpts...); client.IgnoreNotFound(err) != nil {
+		return fmt.Errorf("failed to delete manifestwork for cluster %s due to err %v", clusterName, err)
 	}
 	return nil
 }
@@ -160,10 +160,14 @@ var EnsureInstallPlanIsApproved = func(
 // EnsureManagedClusterView creates or updates a view.
 func EnsureManagedClusterView(
 	ctx context.Context, c client.Client, safeName, name, namespace, resourceType,
-	resourceName, resourceNamespace, cguName, cguNamespace string) (*viewv1beta1.ManagedClusterView, error) {
+	resourceName, resourceNamespace, cguName, cguNamespace string) (mcv *viewv1beta1.ManagedClusterView, err error) {
+	ctx, span := StartSpan(ctx, "EnsureManagedClusterView", TraceAttributeCluster.String(namespace),
+		TraceAttributeCGUName.String(cguName), TraceAttributeCGUNamespace.String(cguNamespace),
+		TraceAttributeResourceKind.String("ManagedClusterView"), TraceAttributeResourceName.String(safeName))
+	defer func() { EndSpan(span, err) }()
 
-	mcv := &viewv1beta1.ManagedClusterView{}
-	err := c.Get(ctx, types.NamespacedName{Name: safeName, Namespace: namespace}, mcv)
+	mcv = &viewv1beta1.ManagedClusterView{}
+	err = c.Get(ctx, types.NamespacedName{Name: safeName, Namespace: namespace}, mcv)
 
 	if err != nil {
 		// If the specific managedClusterView was not found, create it.
@@ -229,9 +233,12 @@ func EnsureManagedClusterView(
 // EnsureManagedClusterActionForInstallPlan creates or updates an action for an InstallPlan.
 func EnsureManagedClusterActionForInstallPlan(
 	ctx context.Context, c client.Client, namespace, cguLabel string,
-	installPlan operatorsv1alpha1.InstallPlan) (*actionv1beta1.ManagedClusterAction, error) {
+	installPlan operatorsv1alpha1.InstallPlan) (mcaForInstallPlan *actionv1beta1.ManagedClusterAction, err error) {
+	ctx, span := StartSpan(ctx, "EnsureManagedClusterActionForInstallPlan", TraceAttributeCluster.String(namespace),
+		TraceAttributeResourceKind.String("ManagedClusterAction"), TraceAttributeResourceName.String(installPlan.Name))
+	defer func() { EndSpan(span, err) }()
 
-	mcaForInstallPlan := &actionv1beta1.ManagedClusterAction{}
+	mcaForInstallPlan = &actionv1beta1.ManagedClusterAction{}
 	if err := c.Get(ctx, types.NamespacedName{Name: installPlan.Name, Namespace: namespace}, mcaForInstallPlan); err != nil {
 		// If the specific managedClusterAction was not found, create it.
 		if errors.IsNotFound(err) {
@@ -352,7 +359,8 @@ func DeleteManagedClusterActions(
 func FinalMultiCloudObjectCleanup(
 	ctx context.Context, c client.Client, clusterGroupUpgrade *ranv1alpha1.ClusterGroupUpgrade) error {
 
-	if !*clusterGroupUpgrade.Spec.Enable {
+	// A paused CGU has started and may still have views and actions to clean up
+	if !*clusterGroupUpgrade.Spec.Enable && clusterGroupUpgrade.Status.Status.StartedAt.IsZero() {
 		return nil
 	}
 
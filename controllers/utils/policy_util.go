@@ -218,14 +218,25 @@ func ShouldSoak(policy *unstructured.Unstructured, firstCompliantAt metav1.Time)
 	if err != nil || soakSeconds < 0 {
 		return false, errors.New("soak annotation value " + soak + " is invalid, value should be an integer equal or greater than 0")
 	}
+	return ShouldSoakFor(soakSeconds, firstCompliantAt), nil
+}
 
+// ShouldSoakFor returns whether a cluster first compliant at the given time has not been compliant for soakSeconds yet
+func ShouldSoakFor(soakSeconds int, firstCompliantAt metav1.Time) bool {
 	if firstCompliantAt.IsZero() {
-		return true, nil
+		return true
 	}
-	if time.Since(firstCompliantAt.Time) > time.Duration(soakSeconds)*time.Second {
-		return false, nil
+	return time.Since(firstCompliantAt.Time) <= time.Duration(soakSeconds)*time.Second
+}
+
+// GetPolicyOverride returns the settings of a managed policy overridden in the CGU, if any
+func GetPolicyOverride(clusterGroupUpgrade *ranv1alpha1.ClusterGroupUpgrade, policyName string) *ranv1alpha1.PolicyOverride {
+	for i := range clusterGroupUpgrade.Spec.PolicyOverrides {
+		if clusterGroupUpgrade.Spec.PolicyOverrides[i].Name == policyName {
+			return &clusterGroupUpgrade.Spec.PolicyOverrides[i]
+		}
 	}
-	return true, nil
+	return nil
 }
 
 // UpdateManagedPolicyNamespaceList updates policyNs with the corresponding namespaces of a managed policy
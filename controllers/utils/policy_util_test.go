@@ -5,6 +5,7 @@ import (
 	"testing"
 	"time"
 
+	ranv1alpha1 "github.com/openshift-kni/cluster-group-upgrades-operator/pkg/api/clustergroupupgrades/v1alpha1"
 	"github.com/stretchr/testify/assert"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
@@ -59,6 +60,23 @@ func TestShouldSoak(t *testing.T) {
 	assert.Error(t, err)
 }
 
+func TestShouldSoakFor(t *testing.T) {
+	assert.True(t, ShouldSoakFor(60, v1.Time{}))
+	assert.True(t, ShouldSoakFor(60, v1.NewTime(time.Now().Add(-30*time.Second))))
+	assert.False(t, ShouldSoakFor(60, v1.NewTime(time.Now().Add(-90*time.Second))))
+	assert.False(t, ShouldSoakFor(0, v1.NewTime(time.Now().Add(-time.Second))))
+}
+
+func TestGetPolicyOverride(t *testing.T) {
+	cgu := &ranv1alpha1.ClusterGroupUpgrade{
+		Spec: ranv1alpha1.ClusterGroupUpgradeSpec{
+			PolicyOverrides: []ranv1alpha1.PolicyOverride{{Name: "policy1"}, {Name: "policy2"}},
+		},
+	}
+	assert.Equal(t, "policy2", GetPolicyOverride(cgu, "policy2").Name)
+	assert.Nil(t, GetPolicyOverride(cgu, "policy3"))
+}
+
 func TestUpdateManagedPolicyNamespaceList(t *testing.T) {
 	testcases := []struct {
 		policiesNs     map[string][]string
@@ -0,0 +1,87 @@
+package utils
+
+import (
+	"context"
+	"os"
+
+	ranv1alpha1 "github.com/openshift-kni/cluster-group-upgrades-operator/pkg/api/clustergroupupgrades/v1alpha1"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	tracerName = "github.com/openshift-kni/cluster-group-upgrades-operator"
+	// TracingServiceName is the service name of the traces, unless OTEL_SERVICE_NAME is set
+	TracingServiceName = "cluster-group-upgrades-operator"
+)
+
+// Attributes of the spans
+const (
+	TraceAttributeCGUName       = attribute.Key("cgu.name")
+	TraceAttributeCGUNamespace  = attribute.Key("cgu.namespace")
+	TraceAttributeBatchIndex    = attribute.Key("cgu.batch_index")
+	TraceAttributeCluster       = attribute.Key("cluster.name")
+	TraceAttributeResourceKind  = attribute.Key("resource.kind")
+	TraceAttributeResourceName  = attribute.Key("resource.name")
+	TraceAttributePreviousState = attribute.Key("precaching.previous_state")
+	TraceAttributeNextState     = attribute.Key("precaching.next_state")
+)
+
+// SetupTracing exports the traces to the OTLP collector set by the OTEL_EXPORTER_OTLP_ENDPOINT or
+// OTEL_EXPORTER_OTLP_TRACES_ENDPOINT environment variables, the other OTEL_EXPORTER_OTLP_* variables are
+// also supported. Tracing is disabled when no endpoint is set.
+// returns: func(context.Context) error, flushing the spans and stopping the exporter
+//
+//	error
+func SetupTracing(ctx context.Context) (func(context.Context) error, error) {
+	if os.Getenv("OTEL_EXPORTER_OTLP_ENDPOINT") == "" && os.Getenv("OTEL_EXPORTER_OTLP_TRACES_ENDPOINT") == "" {
+		return func(context.Context) error { return nil }, nil
+	}
+
+	exporter, err := otlptracegrpc.New(ctx)
+	if err != nil {
+		return nil, err
+	}
+	res, err := resource.New(ctx,
+		resource.WithAttributes(attribute.String("service.name", TracingServiceName)),
+		resource.WithFromEnv(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	tracerProvider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+	)
+	otel.SetTracerProvider(tracerProvider)
+	return tracerProvider.Shutdown, nil
+}
+
+// StartSpan starts a span from the global tracer provider
+func StartSpan(ctx context.Context, name string, attributes ...attribute.KeyValue) (context.Context, trace.Span) {
+	return otel.Tracer(tracerName).Start(ctx, name, trace.WithAttributes(attributes...))
+}
+
+// StartCGUSpan starts a span with the name, namespace and current batch index of the ClusterGroupUpgrade as attributes
+func StartCGUSpan(ctx context.Context, name string, clusterGroupUpgrade *ranv1alpha1.ClusterGroupUpgrade,
+	attributes ...attribute.KeyValue) (context.Context, trace.Span) {
+	return StartSpan(ctx, name, append([]attribute.KeyValue{
+		TraceAttributeCGUName.String(clusterGroupUpgrade.Name),
+		TraceAttributeCGUNamespace.String(clusterGroupUpgrade.Namespace),
+		TraceAttributeBatchIndex.Int(clusterGroupUpgrade.Status.Status.CurrentBatch),
+	}, attributes...)...)
+}
+
+// EndSpan records the error, if any, and ends the span
+func EndSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
@@ -0,0 +1,107 @@
+package utils
+
+import (
+	"context"
+	"errors"
+	"net"
+	"sync"
+	"testing"
+
+	ranv1alpha1 "github.com/openshift-kni/cluster-group-upgrades-operator/pkg/api/clustergroupupgrades/v1alpha1"
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	collectortrace "go.opentelemetry.io/proto/otlp/collector/trace/v1"
+	commonv1 "go.opentelemetry.io/proto/otlp/common/v1"
+	tracev1 "go.opentelemetry.io/proto/otlp/trace/v1"
+	"google.golang.org/grpc"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// fakeCollector stands in for an OTLP collector and keeps the spans it receives
+type fakeCollector struct {
+	collectortrace.UnimplementedTraceServiceServer
+	mutex sync.Mutex
+	spans []*tracev1.Span
+}
+
+func (c *fakeCollector) Export(_ context.Context, req *collectortrace.ExportTraceServiceRequest) (
+	*collectortrace.ExportTraceServiceResponse, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	for _, resourceSpans := range req.GetResourceSpans() {
+		for _, scopeSpans := range resourceSpans.GetScopeSpans() {
+			c.spans = append(c.spans, scopeSpans.GetSpans()...)
+		}
+	}
+	return &collectortrace.ExportTraceServiceResponse{}, nil
+}
+
+func newTestCGU() *ranv1alpha1.ClusterGroupUpgrade {
+	return &ranv1alpha1.ClusterGroupUpgrade{
+		ObjectMeta: metav1.ObjectMeta{Name: "cgu", Namespace: "default"},
+		Status:     ranv1alpha1.ClusterGroupUpgradeStatus{Status: ranv1alpha1.UpgradeStatus{CurrentBatch: 2}},
+	}
+}
+
+func TestSetupTracing(t *testing.T) {
+	defer otel.SetTracerProvider(otel.GetTracerProvider())
+
+	// Disabled without an endpoint
+	shutdown, err := SetupTracing(context.TODO())
+	assert.NoError(t, err)
+	assert.NoError(t, shutdown(context.TODO()))
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.NoError(t, err)
+	collector := &fakeCollector{}
+	server := grpc.NewServer()
+	collectortrace.RegisterTraceServiceServer(server, collector)
+	go func() { _ = server.Serve(listener) }()
+	defer server.Stop()
+
+	t.Setenv("OTEL_EXPORTER_OTLP_ENDPOINT", "http://"+listener.Addr().String())
+	t.Setenv("OTEL_EXPORTER_OTLP_INSECURE", "true")
+	shutdown, err = SetupTracing(context.TODO())
+	assert.NoError(t, err)
+	_, span := StartCGUSpan(context.TODO(), "Reconcile", newTestCGU())
+	span.End()
+	// Shutting down flushes the spans
+	assert.NoError(t, shutdown(context.TODO()))
+
+	collector.mutex.Lock()
+	defer collector.mutex.Unlock()
+	assert.Len(t, collector.spans, 1)
+	assert.Equal(t, "Reconcile", collector.spans[0].GetName())
+	attributes := map[string]*commonv1.AnyValue{}
+	for _, attribute := range collector.spans[0].GetAttributes() {
+		attributes[attribute.GetKey()] = attribute.GetValue()
+	}
+	assert.Equal(t, "cgu", attributes[string(TraceAttributeCGUName)].GetStringValue())
+	assert.Equal(t, int64(2), attributes[string(TraceAttributeBatchIndex)].GetIntValue())
+}
+
+func TestStartCGUSpan(t *testing.T) {
+	defer otel.SetTracerProvider(otel.GetTracerProvider())
+	recorder := tracetest.NewSpanRecorder()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+
+	ctx, parent := StartCGUSpan(context.TODO(), "Reconcile", newTestCGU())
+	_, child := StartSpan(ctx, "GetManagedClusterView", TraceAttributeCluster.String("spoke1"))
+	EndSpan(child, nil)
+	EndSpan(parent, errors.New("failed"))
+
+	spans := recorder.Ended()
+	assert.Len(t, spans, 2)
+	assert.Equal(t, "GetManagedClusterView", spans[0].Name())
+	assert.Equal(t, spans[1].SpanContext().SpanID(), spans[0].Parent().SpanID())
+	assert.Equal(t, codes.Unset, spans[0].Status().Code)
+	assert.Equal(t, "Reconcile", spans[1].Name())
+	assert.Contains(t, spans[1].Attributes(), TraceAttributeCGUName.String("cgu"))
+	assert.Contains(t, spans[1].Attributes(), TraceAttributeCGUNamespace.String("default"))
+	assert.Contains(t, spans[1].Attributes(), TraceAttributeBatchIndex.Int(2))
+	assert.Equal(t, codes.Error, spans[1].Status().Code)
+	assert.Equal(t, "failed", spans[1].Status().Description)
+}
@@ -0,0 +1,294 @@
+/*
+Copyright 2021.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	"github.com/openshift-kni/cluster-group-upgrades-operator/controllers/utils"
+	ranv1alpha1 "github.com/openshift-kni/cluster-group-upgrades-operator/pkg/api/clustergroupupgrades/v1alpha1"
+)
+
+// SetupClusterGroupUpgradeWebhookWithManager registers the webhooks for ClusterGroupUpgrade in the manager.
+func SetupClusterGroupUpgradeWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr, &ranv1alpha1.ClusterGroupUpgrade{}).
+		WithDefaulter(&ClusterGroupUpgradeCustomDefaulter{}).
+		WithValidator(&ClusterGroupUpgradeCustomValidator{}).
+		Complete()
+}
+
+//+kubebuilder:webhook:path=/mutate-ran-openshift-io-v1alpha1-clustergroupupgrade,mutating=true,failurePolicy=fail,sideEffects=None,groups=ran.openshift.io,resources=clustergroupupgrades,verbs=create;update,versions=v1alpha1,name=mclustergroupupgrade-v1alpha1.kb.io,admissionReviewVersions=v1
+
+// ClusterGroupUpgradeCustomDefaulter sets the default values of the ClusterGroupUpgrade fields that the CRD
+// defaults don't cover, e.g. the ones set to their zero value or whose parent is omitted.
+type ClusterGroupUpgradeCustomDefaulter struct{}
+
+var _ admission.Defaulter[*ranv1alpha1.ClusterGroupUpgrade] = &ClusterGroupUpgradeCustomDefaulter{}
+
+// Default implements admission.Defaulter so a webhook will be registered for the type ClusterGroupUpgrade.
+func (d *ClusterGroupUpgradeCustomDefaulter) Default(_ context.Context, cgu *ranv1alpha1.ClusterGroupUpgrade) error {
+	if cgu.Spec.Enable == nil {
+		enable := true
+		cgu.Spec.Enable = &enable
+	}
+	if cgu.Spec.BatchTimeoutAction == "" {
+		cgu.Spec.BatchTimeoutAction = ranv1alpha1.BatchTimeoutAction.Continue
+	}
+	if cgu.Spec.ConflictAction == "" {
+		cgu.Spec.ConflictAction = ranv1alpha1.ConflictAction.Wait
+	}
+	if cgu.Spec.Actions.AfterCompletion != nil && cgu.Spec.Actions.AfterCompletion.DeleteObjects == nil {
+		deleteObjects := true
+		cgu.Spec.Actions.AfterCompletion.DeleteObjects = &deleteObjects
+	}
+
+	if cgu.Spec.RemediationStrategy == nil {
+		cgu.Spec.RemediationStrategy = &ranv1alpha1.RemediationStrategySpec{}
+	}
+	strategy := cgu.Spec.RemediationStrategy
+	if strategy.Timeout == 0 {
+		strategy.Timeout = utils.DefaultCGUTimeoutMinutes
+	}
+	if strategy.Mode == "" {
+		strategy.Mode = ranv1alpha1.RemediationMode.Batch
+	}
+	if strategy.OnCanaryFailure == "" {
+		strategy.OnCanaryFailure = ranv1alpha1.OnCanaryFailureAction.Stop
+	}
+	if strategy.SpreadBy != nil && strategy.SpreadBy.MaxPerBatch == 0 {
+		strategy.SpreadBy.MaxPerBatch = 1
+	}
+	if strategy.UnavailableClusters != nil && strategy.UnavailableClusters.Action == "" {
+		strategy.UnavailableClusters.Action = ranv1alpha1.UnavailableClustersAction.Skip
+	}
+	return nil
+}
+
+//+kubebuilder:webhook:path=/validate-ran-openshift-io-v1alpha1-clustergroupupgrade,mutating=false,failurePolicy=fail,sideEffects=None,groups=ran.openshift.io,resources=clustergroupupgrades,verbs=create;update,versions=v1alpha1,name=vclustergroupupgrade-v1alpha1.kb.io,admissionReviewVersions=v1
+
+// ClusterGroupUpgradeCustomValidator rejects the ClusterGroupUpgrades that the controller would fail to validate
+// later on, and the changes to the fields that the controller ignores once the upgrade has started.
+type ClusterGroupUpgradeCustomValidator struct{}
+
+var _ admission.Validator[*ranv1alpha1.ClusterGroupUpgrade] = &ClusterGroupUpgradeCustomValidator{}
+
+// ValidateCreate implements admission.Validator so a webhook will be registered for the type ClusterGroupUpgrade.
+func (v *ClusterGroupUpgradeCustomValidator) ValidateCreate(
+	_ context.Context, cgu *ranv1alpha1.ClusterGroupUpgrade) (admission.Warnings, error) {
+	return nil, toInvalidError(cgu, validateSpec(nil, cgu))
+}
+
+// ValidateUpdate implements admission.Validator so a webhook will be registered for the type ClusterGroupUpgrade.
+func (v *ClusterGroupUpgradeCustomValidator) ValidateUpdate(
+	_ context.Context, oldCgu, cgu *ranv1alpha1.ClusterGroupUpgrade) (admission.Warnings, error) {
+	// Metadata updates, e.g. the finalizer being removed, must go through whatever the spec is
+	if cgu.GetDeletionTimestamp() != nil || equality.Semantic.DeepEqual(oldCgu.Spec, cgu.Spec) {
+		return nil, nil
+	}
+	allErrs := validateSpec(oldCgu, cgu)
+	if hasStarted(oldCgu) {
+		allErrs = append(allErrs, validateImmutableFields(oldCgu, cgu)...)
+	}
+	return nil, toInvalidError(cgu, allErrs)
+}
+
+// ValidateDelete implements admission.Validator so a webhook will be registered for the type ClusterGroupUpgrade.
+func (v *ClusterGroupUpgradeCustomValidator) ValidateDelete(
+	_ context.Context, _ *ranv1alpha1.ClusterGroupUpgrade) (admission.Warnings, error) {
+	return nil, nil
+}
+
+func toInvalidError(cgu *ranv1alpha1.ClusterGroupUpgrade, allErrs field.ErrorList) error {
+	if len(allErrs) == 0 {
+		return nil
+	}
+	return apierrors.NewInvalid(ranv1alpha1.Kind("ClusterGroupUpgrade"), cgu.Name, allErrs)
+}
+
+// hasStarted tells whether the ClusterGroupUpgrade is in progress or done
+func hasStarted(cgu *ranv1alpha1.ClusterGroupUpgrade) bool {
+	return meta.IsStatusConditionTrue(cgu.Status.Conditions, string(utils.ConditionTypes.Progressing)) ||
+		utils.IsStatusConditionPresent(cgu.Status.Conditions, string(utils.ConditionTypes.Succeeded))
+}
+
+// validateSpec runs the checks of the controller validation that don't need to look up other resources.
+// oldCgu is the stored ClusterGroupUpgrade on update, nil on create.
+func validateSpec(oldCgu, cgu *ranv1alpha1.ClusterGroupUpgrade) field.ErrorList {
+	var allErrs field.ErrorList
+	specPath := field.NewPath("spec")
+
+	if len(cgu.Spec.ManagedPolicies) > 0 && len(cgu.Spec.ManifestWorkTemplates) > 0 {
+		allErrs = append(allErrs, field.Forbidden(specPath.Child("manifestWorkTemplates"),
+			"managedPolicies and manifestWorkTemplates cannot be used together"))
+	}
+	switch cgu.Spec.BatchTimeoutAction {
+	case "", ranv1alpha1.BatchTimeoutAction.Continue, ranv1alpha1.BatchTimeoutAction.Abort:
+	default:
+		allErrs = append(allErrs, field.NotSupported(specPath.Child("batchTimeoutAction"), cgu.Spec.BatchTimeoutAction,
+			[]string{ranv1alpha1.BatchTimeoutAction.Continue, ranv1alpha1.BatchTimeoutAction.Abort}))
+	}
+
+	// nolint: staticcheck
+	for i, clusterSelector := range cgu.Spec.ClusterSelector {
+		selectorList := strings.Split(clusterSelector, "=")
+		if len(selectorList) > 2 || selectorList[0] == "" {
+			allErrs = append(allErrs, field.Invalid(specPath.Child("clusterSelector").Index(i), clusterSelector,
+				"expected label or label=value"))
+		}
+	}
+	for i := range cgu.Spec.ClusterLabelSelectors {
+		if _, err := metav1.LabelSelectorAsSelector(&cgu.Spec.ClusterLabelSelectors[i]); err != nil {
+			allErrs = append(allErrs, field.Invalid(specPath.Child("clusterLabelSelectors").Index(i),
+				cgu.Spec.ClusterLabelSelectors[i], err.Error()))
+		}
+	}
+	// nolint: staticcheck
+	if cgu.Spec.RetryOf != nil &&
+		(cgu.Spec.Clusters != nil || cgu.Spec.ClusterSelector != nil || cgu.Spec.ClusterLabelSelectors != nil) {
+		allErrs = append(allErrs, field.Forbidden(specPath.Child("retryOf"),
+			"retryOf cannot be used together with clusters, clusterSelector or clusterLabelSelectors"))
+	}
+
+	for i, override := range cgu.Spec.PolicyOverrides {
+		if _, found := utils.FindStringInSlice(cgu.Spec.ManagedPolicies, override.Name); !found {
+			allErrs = append(allErrs, field.Invalid(specPath.Child("policyOverrides").Index(i).Child("name"),
+				override.Name, "policy is not in the managedPolicies"))
+		}
+	}
+	if err := utils.ValidateMaintenanceWindows(cgu.Spec.MaintenanceWindows); err != nil {
+		allErrs = append(allErrs, field.Invalid(specPath.Child("maintenanceWindows"), cgu.Spec.MaintenanceWindows, err.Error()))
+	}
+
+	if cgu.Spec.RemediationStrategy == nil {
+		allErrs = append(allErrs, field.Required(specPath.Child("remediationStrategy"), ""))
+		return allErrs
+	}
+	var oldStrategy *ranv1alpha1.RemediationStrategySpec
+	if oldCgu != nil {
+		oldStrategy = oldCgu.Spec.RemediationStrategy
+	}
+	return append(allErrs, validateRemediationStrategy(cgu, oldStrategy, specPath.Child("remediationStrategy"))...)
+}
+
+func validateRemediationStrategy(
+	cgu *ranv1alpha1.ClusterGroupUpgrade, oldStrategy *ranv1alpha1.RemediationStrategySpec, strategyPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	strategy := cgu.Spec.RemediationStrategy
+
+	// The canaries can only be checked here when the clusters are listed explicitly
+	// nolint: staticcheck
+	if cgu.Spec.ClusterSelector == nil && cgu.Spec.ClusterLabelSelectors == nil && cgu.Spec.RetryOf == nil {
+		for i, canary := range strategy.Canaries {
+			if _, found := utils.FindStringInSlice(cgu.Spec.Clusters, canary); !found {
+				allErrs = append(allErrs, field.Invalid(strategyPath.Child("canaries").Index(i), canary,
+					"canary cluster is not in the list of clusters"))
+			}
+		}
+	}
+
+	// maxConcurrency is ignored when maxConcurrencyPercentage is set. Its minimum was never enforced by the CRD,
+	// so it is only checked when it changes to keep the stored ClusterGroupUpgrades with 0 updatable.
+	maxConcurrencyChanged := oldStrategy == nil || oldStrategy.MaxConcurrency != strategy.MaxConcurrency ||
+		oldStrategy.MaxConcurrencyPercentage != strategy.MaxConcurrencyPercentage
+	if maxConcurrencyChanged && strategy.MaxConcurrencyPercentage == 0 && strategy.MaxConcurrency < 1 {
+		allErrs = append(allErrs, field.Invalid(strategyPath.Child("maxConcurrency"), strategy.MaxConcurrency, "must be at least 1"))
+	}
+	if strategy.MaxConcurrencyPercentage < 0 || strategy.MaxConcurrencyPercentage > 100 {
+		allErrs = append(allErrs, field.Invalid(strategyPath.Child("maxConcurrencyPercentage"),
+			strategy.MaxConcurrencyPercentage, "must be between 1 and 100"))
+	}
+	// The errors don't depend on the number of clusters
+	if _, err := utils.GetBatchSizes(strategy.BatchSizes, 100); err != nil {
+		allErrs = append(allErrs, field.Invalid(strategyPath.Child("batchSizes"), strategy.BatchSizes, err.Error()))
+	}
+	if _, err := utils.GetMaxFailures(strategy.MaxFailures, 100); err != nil {
+		allErrs = append(allErrs, field.Invalid(strategyPath.Child("maxFailures"), strategy.MaxFailures.String(), err.Error()))
+	}
+
+	if strategy.OnCanaryFailure == ranv1alpha1.OnCanaryFailureAction.Rollback {
+		if cgu.RolloutType() != ranv1alpha1.RolloutTypes.Policy {
+			allErrs = append(allErrs, field.Forbidden(strategyPath.Child("onCanaryFailure"),
+				"Rollback is only supported with managedPolicies"))
+		}
+		if len(strategy.RollbackPolicies) == 0 {
+			allErrs = append(allErrs, field.Required(strategyPath.Child("rollbackPolicies"),
+				"rollbackPolicies must be set when onCanaryFailure is Rollback"))
+		}
+	}
+	if strategy.Mode == ranv1alpha1.RemediationMode.Rolling &&
+		(len(strategy.BatchSizes) > 0 || strategy.SpreadBy != nil || strategy.BatchBy != "") {
+		allErrs = append(allErrs, field.Forbidden(strategyPath.Child("mode"),
+			"batchSizes, spreadBy and batchBy cannot be used in Rolling mode"))
+	}
+	if strategy.SpreadBy != nil {
+		if strategy.BatchBy != "" {
+			allErrs = append(allErrs, field.Forbidden(strategyPath.Child("batchBy"), "spreadBy and batchBy cannot be used together"))
+		}
+		if strategy.SpreadBy.LabelKey == "" {
+			allErrs = append(allErrs, field.Required(strategyPath.Child("spreadBy", "labelKey"), ""))
+		}
+	}
+	return allErrs
+}
+
+// validateImmutableFields rejects the changes the controller would ignore once the upgrade has started
+func validateImmutableFields(oldCgu, cgu *ranv1alpha1.ClusterGroupUpgrade) field.ErrorList {
+	type immutableField struct {
+		path     *field.Path
+		oldValue interface{}
+		newValue interface{}
+	}
+	specPath := field.NewPath("spec")
+	fields := []immutableField{
+		{specPath.Child("managedPolicies"), oldCgu.Spec.ManagedPolicies, cgu.Spec.ManagedPolicies},
+		{specPath.Child("manifestWorkTemplates"), oldCgu.Spec.ManifestWorkTemplates, cgu.Spec.ManifestWorkTemplates},
+		{specPath.Child("retryOf"), oldCgu.Spec.RetryOf, cgu.Spec.RetryOf},
+	}
+	// With dynamicMembership, the cluster selection is followed while the upgrade is in progress
+	if !cgu.Spec.DynamicMembership {
+		// nolint: staticcheck
+		fields = append(fields,
+			immutableField{specPath.Child("clusters"), oldCgu.Spec.Clusters, cgu.Spec.Clusters},
+			immutableField{specPath.Child("clusterSelector"), oldCgu.Spec.ClusterSelector, cgu.Spec.ClusterSelector},
+			immutableField{specPath.Child("clusterLabelSelectors"), oldCgu.Spec.ClusterLabelSelectors, cgu.Spec.ClusterLabelSelectors},
+		)
+	}
+	if oldCgu.Spec.RemediationStrategy != nil && cgu.Spec.RemediationStrategy != nil {
+		strategyPath := specPath.Child("remediationStrategy")
+		fields = append(fields,
+			immutableField{strategyPath.Child("canaries"), oldCgu.Spec.RemediationStrategy.Canaries, cgu.Spec.RemediationStrategy.Canaries},
+			immutableField{strategyPath.Child("mode"), oldCgu.Spec.RemediationStrategy.Mode, cgu.Spec.RemediationStrategy.Mode},
+		)
+	}
+
+	var allErrs field.ErrorList
+	for _, f := range fields {
+		if !equality.Semantic.DeepEqual(f.oldValue, f.newValue) {
+			allErrs = append(allErrs, field.Forbidden(f.path, "cannot be changed once the ClusterGroupUpgrade has started"))
+		}
+	}
+	return allErrs
+}
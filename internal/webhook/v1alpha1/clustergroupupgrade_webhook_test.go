@@ -0,0 +1,198 @@
+package v1alpha1
+
+import (
+	"context"
+	"testing"
+
+	"github.com/openshift-kni/cluster-group-upgrades-operator/controllers/utils"
+	ranv1alpha1 "github.com/openshift-kni/cluster-group-upgrades-operator/pkg/api/clustergroupupgrades/v1alpha1"
+	ranv1beta1 "github.com/openshift-kni/cluster-group-upgrades-operator/pkg/api/clustergroupupgrades/v1beta1"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/conversion"
+)
+
+func newCGU() *ranv1alpha1.ClusterGroupUpgrade {
+	return &ranv1alpha1.ClusterGroupUpgrade{
+		ObjectMeta: metav1.ObjectMeta{Name: "cgu", Namespace: "default"},
+		Spec: ranv1alpha1.ClusterGroupUpgradeSpec{
+			Clusters:        []string{"spoke1", "spoke2", "spoke3"},
+			ManagedPolicies: []string{"policy1", "policy2"},
+			RemediationStrategy: &ranv1alpha1.RemediationStrategySpec{
+				MaxConcurrency: 2,
+				Canaries:       []string{"spoke1"},
+			},
+		},
+	}
+}
+
+func TestClusterGroupUpgradeCustomDefaulter(t *testing.T) {
+	cgu := &ranv1alpha1.ClusterGroupUpgrade{}
+	assert.NoError(t, (&ClusterGroupUpgradeCustomDefaulter{}).Default(context.TODO(), cgu))
+	assert.True(t, *cgu.Spec.Enable)
+	assert.Equal(t, ranv1alpha1.BatchTimeoutAction.Continue, cgu.Spec.BatchTimeoutAction)
+	assert.Equal(t, ranv1alpha1.ConflictAction.Wait, cgu.Spec.ConflictAction)
+	assert.Equal(t, utils.DefaultCGUTimeoutMinutes, cgu.Spec.RemediationStrategy.Timeout)
+	assert.Equal(t, ranv1alpha1.RemediationMode.Batch, cgu.Spec.RemediationStrategy.Mode)
+	assert.Equal(t, ranv1alpha1.OnCanaryFailureAction.Stop, cgu.Spec.RemediationStrategy.OnCanaryFailure)
+
+	// Values already set are kept
+	disable := false
+	cgu = newCGU()
+	cgu.Spec.Enable = &disable
+	cgu.Spec.BatchTimeoutAction = ranv1alpha1.BatchTimeoutAction.Abort
+	cgu.Spec.RemediationStrategy.Timeout = 60
+	assert.NoError(t, (&ClusterGroupUpgradeCustomDefaulter{}).Default(context.TODO(), cgu))
+	assert.False(t, *cgu.Spec.Enable)
+	assert.Equal(t, ranv1alpha1.BatchTimeoutAction.Abort, cgu.Spec.BatchTimeoutAction)
+	assert.Equal(t, 60, cgu.Spec.RemediationStrategy.Timeout)
+}
+
+func TestClusterGroupUpgradeCustomValidator_ValidateCreate(t *testing.T) {
+	testcases := []struct {
+		name   string
+		mutate func(*ranv1alpha1.ClusterGroupUpgrade)
+		errMsg string
+	}{
+		{
+			name:   "valid",
+			mutate: func(*ranv1alpha1.ClusterGroupUpgrade) {},
+		},
+		{
+			name: "canary not in the cluster list",
+			mutate: func(cgu *ranv1alpha1.ClusterGroupUpgrade) {
+				cgu.Spec.RemediationStrategy.Canaries = []string{"spoke4"}
+			},
+			errMsg: "spec.remediationStrategy.canaries[0]",
+		},
+		{
+			name: "managedPolicies and manifestWorkTemplates",
+			mutate: func(cgu *ranv1alpha1.ClusterGroupUpgrade) {
+				cgu.Spec.ManifestWorkTemplates = []string{"mwrs1"}
+			},
+			errMsg: "spec.manifestWorkTemplates",
+		},
+		{
+			name: "invalid batchTimeoutAction",
+			mutate: func(cgu *ranv1alpha1.ClusterGroupUpgrade) {
+				cgu.Spec.BatchTimeoutAction = "Retry"
+			},
+			errMsg: "spec.batchTimeoutAction",
+		},
+		{
+			name: "malformed clusterSelector",
+			mutate: func(cgu *ranv1alpha1.ClusterGroupUpgrade) {
+				cgu.Spec.ClusterSelector = []string{"a=b=c"} // nolint: staticcheck
+			},
+			errMsg: "spec.clusterSelector[0]",
+		},
+		{
+			name: "maxConcurrency lower than 1",
+			mutate: func(cgu *ranv1alpha1.ClusterGroupUpgrade) {
+				cgu.Spec.RemediationStrategy.MaxConcurrency = 0
+			},
+			errMsg: "spec.remediationStrategy.maxConcurrency",
+		},
+		{
+			name: "maxConcurrencyPercentage greater than 100",
+			mutate: func(cgu *ranv1alpha1.ClusterGroupUpgrade) {
+				cgu.Spec.RemediationStrategy.MaxConcurrencyPercentage = 150
+			},
+			errMsg: "spec.remediationStrategy.maxConcurrencyPercentage",
+		},
+		{
+			name: "maxConcurrencyPercentage instead of maxConcurrency",
+			mutate: func(cgu *ranv1alpha1.ClusterGroupUpgrade) {
+				cgu.Spec.RemediationStrategy.MaxConcurrency = 0
+				cgu.Spec.RemediationStrategy.MaxConcurrencyPercentage = 10
+			},
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			cgu := newCGU()
+			tc.mutate(cgu)
+			_, err := (&ClusterGroupUpgradeCustomValidator{}).ValidateCreate(context.TODO(), cgu)
+			if tc.errMsg == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorContains(t, err, tc.errMsg)
+			}
+		})
+	}
+}
+
+func TestClusterGroupUpgradeCustomValidator_ValidateUpdate(t *testing.T) {
+	validator := &ClusterGroupUpgradeCustomValidator{}
+	oldCgu := newCGU()
+
+	// Not started yet, anything valid can be changed
+	cgu := newCGU()
+	cgu.Spec.Clusters = append(cgu.Spec.Clusters, "spoke4")
+	cgu.Spec.ManagedPolicies = []string{"policy1"}
+	_, err := validator.ValidateUpdate(context.TODO(), oldCgu, cgu)
+	assert.NoError(t, err)
+
+	oldCgu.Status.Conditions = []metav1.Condition{{Type: string(utils.ConditionTypes.Progressing),
+		Status: metav1.ConditionTrue, Reason: string(utils.ConditionReasons.InProgress)}}
+	_, err = validator.ValidateUpdate(context.TODO(), oldCgu, cgu)
+	assert.ErrorContains(t, err, "spec.clusters: Forbidden")
+	assert.ErrorContains(t, err, "spec.managedPolicies: Forbidden")
+
+	// The cluster selection follows dynamicMembership
+	oldCgu.Spec.DynamicMembership = true
+	cgu.Spec.DynamicMembership = true
+	cgu.Spec.ManagedPolicies = oldCgu.Spec.ManagedPolicies
+	_, err = validator.ValidateUpdate(context.TODO(), oldCgu, cgu)
+	assert.NoError(t, err)
+
+	// Mutable fields can still be changed
+	cgu = oldCgu.DeepCopy()
+	cgu.Spec.RemediationStrategy.Timeout = 120
+	cgu.Spec.BatchTimeoutAction = ranv1alpha1.BatchTimeoutAction.Abort
+	_, err = validator.ValidateUpdate(context.TODO(), oldCgu, cgu)
+	assert.NoError(t, err)
+
+	// Metadata updates go through even if the spec is invalid
+	oldCgu.Spec.BatchTimeoutAction = "Retry"
+	cgu = oldCgu.DeepCopy()
+	cgu.Finalizers = nil
+	_, err = validator.ValidateUpdate(context.TODO(), oldCgu, cgu)
+	assert.NoError(t, err)
+}
+
+func TestClusterGroupUpgradeCustomValidator_ValidateUpdateStoredMaxConcurrency(t *testing.T) {
+	validator := &ClusterGroupUpgradeCustomValidator{}
+	// Stored before the minimum of maxConcurrency was enforced
+	oldCgu := newCGU()
+	oldCgu.Spec.RemediationStrategy.MaxConcurrency = 0
+
+	// Other changes go through
+	cgu := oldCgu.DeepCopy()
+	cgu.Spec.RemediationStrategy.Timeout = 120
+	_, err := validator.ValidateUpdate(context.TODO(), oldCgu, cgu)
+	assert.NoError(t, err)
+
+	// Setting it to 0 again is rejected
+	oldCgu.Spec.RemediationStrategy.MaxConcurrency = 2
+	_, err = validator.ValidateUpdate(context.TODO(), oldCgu, cgu)
+	assert.ErrorContains(t, err, "spec.remediationStrategy.maxConcurrency")
+
+	// Dropping maxConcurrencyPercentage makes maxConcurrency used again
+	oldCgu.Spec.RemediationStrategy.MaxConcurrency = 0
+	oldCgu.Spec.RemediationStrategy.MaxConcurrencyPercentage = 10
+	_, err = validator.ValidateUpdate(context.TODO(), oldCgu, cgu)
+	assert.ErrorContains(t, err, "spec.remediationStrategy.maxConcurrency")
+}
+
+func TestConvertibleTypes(t *testing.T) {
+	scheme := runtime.NewScheme()
+	assert.NoError(t, ranv1alpha1.AddToScheme(scheme))
+	assert.NoError(t, ranv1beta1.AddToScheme(scheme))
+	for _, obj := range []runtime.Object{&ranv1alpha1.ClusterGroupUpgrade{}, &ranv1alpha1.PreCachingConfig{}} {
+		convertible, err := conversion.IsConvertible(scheme, obj)
+		assert.NoError(t, err)
+		assert.True(t, convertible)
+	}
+}
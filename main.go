@@ -21,11 +21,16 @@ import (
 	"crypto/tls"
 	"flag"
 	"os"
+	"time"
+	// Embed the time zone database, maintenance windows can be set in any time zone
+	_ "time/tzdata"
 
 	// Import all Kubernetes client auth plugins (e.g. Azure, GCP, OIDC, etc.)
 	// to ensure that exec-entrypoint and run can make use of them.
 	_ "k8s.io/client-go/plugin/pkg/client/auth"
 
+	coordinationv1 "k8s.io/api/coordination/v1"
+	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/selection"
@@ -36,11 +41,16 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/healthz"
 	"sigs.k8s.io/controller-runtime/pkg/log/zap"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
 	"sigs.k8s.io/controller-runtime/pkg/metrics/filters"
 	"sigs.k8s.io/controller-runtime/pkg/metrics/server"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
 
 	"github.com/openshift-kni/cluster-group-upgrades-operator/controllers"
+	"github.com/openshift-kni/cluster-group-upgrades-operator/controllers/utils"
+	webhookv1alpha1 "github.com/openshift-kni/cluster-group-upgrades-operator/internal/webhook/v1alpha1"
 	ranv1alpha1 "github.com/openshift-kni/cluster-group-upgrades-operator/pkg/api/clustergroupupgrades/v1alpha1"
+	ranv1beta1 "github.com/openshift-kni/cluster-group-upgrades-operator/pkg/api/clustergroupupgrades/v1beta1"
 	ibguv1alpha1 "github.com/openshift-kni/cluster-group-upgrades-operator/pkg/api/imagebasedgroupupgrades/v1alpha1"
 	ocpv1 "github.com/openshift/api/config/v1"
 	utiltls "github.com/openshift/controller-runtime-common/pkg/tls"
@@ -73,6 +83,7 @@ func init() {
 	utilruntime.Must(mwv1alpha1.Install(scheme))
 	utilruntime.Must(policiesv1.AddToScheme(scheme))
 	utilruntime.Must(ranv1alpha1.AddToScheme(scheme))
+	utilruntime.Must(ranv1beta1.AddToScheme(scheme))
 	utilruntime.Must(ibguv1alpha1.AddToScheme(scheme))
 	utilruntime.Must(viewv1beta1.AddToScheme(scheme))
 	utilruntime.Must(actionv1beta1.AddToScheme(scheme))
@@ -86,6 +97,8 @@ func main() {
 	var probeAddr string
 	var skipTLSProfile bool
 	var metricsCertDir string
+	var enableWebhooks bool
+	var webhookCertDir string
 
 	flag.StringVar(&metricsAddr, "metrics-bind-address", ":8080", "The address the metric endpoint binds to.")
 	flag.StringVar(&probeAddr, "health-probe-bind-address", ":8081", "The address the probe endpoint binds to.")
@@ -97,6 +110,11 @@ func main() {
 			"Use this flag when running on vanilla Kubernetes.")
 	flag.StringVar(&metricsCertDir, "metrics-tls-cert-dir", "",
 		"The directory containing the tls.crt and tls.key.")
+	flag.BoolVar(&enableWebhooks, "enable-webhooks", false,
+		"Enable the ClusterGroupUpgrade admission webhooks and the conversion webhooks of the served API versions. "+
+			"The webhook configurations and serving certificate have to be deployed as well.")
+	flag.StringVar(&webhookCertDir, "webhook-tls-cert-dir", "",
+		"The directory containing the tls.crt and tls.key of the webhook server.")
 	opts := zap.Options{
 		Development: true,
 	}
@@ -159,11 +177,18 @@ func main() {
 			TLSOpts:        []func(*tls.Config){tlsConfig},
 			CertDir:        metricsCertDir,
 		},
+		WebhookServer: webhook.NewServer(webhook.Options{
+			CertDir: webhookCertDir,
+			TLSOpts: []func(*tls.Config){tlsConfig},
+		}),
 		Cache: cache.Options{
 			ByObject: map[client.Object]cache.ByObject{
 				&mwv1.ManifestWork{}: {
 					Label: selector,
 				},
+				&coordinationv1.Lease{}: {
+					Field: fields.OneTermEqualSelector("metadata.name", utils.ManagedClusterLeaseName),
+				},
 			},
 		},
 	})
@@ -172,16 +197,36 @@ func main() {
 		os.Exit(1)
 	}
 
+	// The notifier is stopped by the manager once the queued notifications are sent
+	notifier := controllers.NewNotifier(mgr.GetClient(), ctrl.Log.WithName("Notifier"), os.Getenv("POD_NAMESPACE"))
+	if err = mgr.Add(notifier); err != nil {
+		setupLog.Error(err, "unable to add the notifier")
+		os.Exit(1)
+	}
+
 	if err = (&controllers.ClusterGroupUpgradeReconciler{
-		Client: mgr.GetClient(),
-		Log:    ctrl.Log.WithName("controllers").WithName("ClusterGroupUpgrade"),
-		Scheme: mgr.GetScheme(),
+		Client:   mgr.GetClient(),
+		Log:      ctrl.Log.WithName("controllers").WithName("ClusterGroupUpgrade"),
+		Scheme:   mgr.GetScheme(),
+		Notifier: notifier,
 	}).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "ClusterGroupUpgrade")
 		os.Exit(1)
 	}
+	if enableWebhooks {
+		if err = webhookv1alpha1.SetupClusterGroupUpgradeWebhookWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create webhook", "webhook", "ClusterGroupUpgrade")
+			os.Exit(1)
+		}
+		if err = webhookv1alpha1.SetupPreCachingConfigWebhookWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create webhook", "webhook", "PreCachingConfig")
+			os.Exit(1)
+		}
+	}
 	//+kubebuilder:scaffold:builder
 
+	controllers.RegisterMetrics(metrics.Registry)
+
 	if err = (&controllers.ManagedClusterForCguReconciler{
 		Client: mgr.GetClient(),
 		Log:    ctrl.Log.WithName("controllers").WithName("ManagedClusterForCGU"),
@@ -200,6 +245,20 @@ func main() {
 		os.Exit(1)
 	}
 
+	if podNamespace := os.Getenv("POD_NAMESPACE"); podNamespace != "" {
+		if err = (&controllers.UpgradeAlertsConfigReconciler{
+			Client:    mgr.GetClient(),
+			Log:       ctrl.Log.WithName("controllers").WithName("UpgradeAlertsConfig"),
+			Scheme:    mgr.GetScheme(),
+			Namespace: podNamespace,
+		}).SetupWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create controller", "controller", "UpgradeAlertsConfig")
+			os.Exit(1)
+		}
+	} else {
+		setupLog.Info("POD_NAMESPACE is not set, the PrometheusRule of the UpgradeAlertsConfig will not be created")
+	}
+
 	if err := mgr.AddHealthzCheck("healthz", healthz.Ping); err != nil {
 		setupLog.Error(err, "unable to set up health check")
 		os.Exit(1)
@@ -234,6 +293,21 @@ func main() {
 		}
 	}
 
+	// Export the traces of the reconciliations if an OTLP endpoint is set
+	shutdownTracing, err := utils.SetupTracing(ctx)
+	if err != nil {
+		setupLog.Error(err, "unable to set up tracing")
+		//nolint:gocritic,exitAfterDefer
+		os.Exit(1)
+	}
+	defer func() {
+		shutdownCtx, shutdownCancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer shutdownCancel()
+		if err := shutdownTracing(shutdownCtx); err != nil {
+			setupLog.Error(err, "unable to flush the traces")
+		}
+	}()
+
 	setupLog.Info("starting manager")
 	if err := mgr.Start(ctx); err != nil {
 		setupLog.Error(err, "problem running manager")
@@ -0,0 +1,7 @@
+package v1alpha1
+
+// Hub marks ClusterGroupUpgrade v1alpha1, the storage version, as the version the other ones are converted to
+func (*ClusterGroupUpgrade) Hub() {}
+
+// Hub marks PreCachingConfig v1alpha1, the storage version, as the version the other ones are converted to
+func (*PreCachingConfig) Hub() {}
@@ -34,6 +34,12 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&ClusterGroupUpgradeList{},
 		&PreCachingConfig{},
 		&PreCachingConfigList{},
+		&UpgradeConcurrencyPolicy{},
+		&UpgradeConcurrencyPolicyList{},
+		&UpgradeAlertsConfig{},
+		&UpgradeAlertsConfigList{},
+		&UpgradeNotificationEndpoint{},
+		&UpgradeNotificationEndpointList{},
 	)
 	metav1.AddToGroupVersion(scheme, SchemeGroupVersion)
 	return nil
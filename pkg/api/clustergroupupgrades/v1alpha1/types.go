@@ -18,20 +18,201 @@ package v1alpha1
 
 import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	mwv1 "open-cluster-management.io/api/work/v1"
 )
 
 // EDIT THIS FILE!  THIS IS SCAFFOLDING FOR YOU TO OWN!
 // NOTE: json tags are required.  Any new fields you add must have json tags for the fields to be serialized.
 
+// SpreadBySpec limits how many clusters of the same topology domain can be remediated in the same batch
+type SpreadBySpec struct {
+	// LabelKey is the ManagedCluster label identifying the topology domain of a cluster, e.g. its site or region
+	LabelKey string `json:"labelKey"`
+	// MaxPerBatch is the maximum number of clusters with the same LabelKey value in a single batch
+	//+kubebuilder:default=1
+	//+kubebuilder:validation:Minimum=1
+	MaxPerBatch int `json:"maxPerBatch,omitempty"`
+}
+
+// UnavailableClustersSpec defines how the clusters that are unavailable when their batch starts are handled
+type UnavailableClustersSpec struct {
+	// Action is Skip, to remove the unavailable clusters from the CGU, or Defer, to move them to the end of the
+	// remediation plan. Deferred clusters that are still unavailable when the last batch starts are skipped.
+	//+kubebuilder:validation:Enum=Skip;Defer
+	//+kubebuilder:default=Skip
+	Action string `json:"action,omitempty"`
+	// MaxLeaseAge also considers a cluster unavailable when its lease has not been renewed for longer than that,
+	// e.g. "5m", even if its ManagedCluster is still reported as available.
+	MaxLeaseAge *metav1.Duration `json:"maxLeaseAge,omitempty"`
+}
+
+// UnavailableClustersAction selections
+var UnavailableClustersAction = struct {
+	Skip  string
+	Defer string
+}{
+	Skip:  "Skip",
+	Defer: "Defer",
+}
+
+// MaintenanceWindow defines a recurring period of time during which remediation can start
+type MaintenanceWindow struct {
+	// DaysOfWeek are the days the window opens on. The window opens every day if empty.
+	//+kubebuilder:validation:items:Enum=Mon;Tue;Wed;Thu;Fri;Sat;Sun
+	DaysOfWeek []string `json:"daysOfWeek,omitempty"`
+	// StartTime is the time of the day the window opens, in the HH:MM 24-hour format
+	//+kubebuilder:validation:Pattern=`^([01][0-9]|2[0-3]):[0-5][0-9]$`
+	StartTime string `json:"startTime"`
+	// Duration is how long the window stays open, e.g. "4h" or "90m"
+	Duration metav1.Duration `json:"duration"`
+	// TimeZone is the IANA time zone name of StartTime, e.g. "Europe/Madrid". Defaults to UTC.
+	TimeZone string `json:"timeZone,omitempty"`
+}
+
+// ExpectedCondition defines the status a condition of a resource must have
+type ExpectedCondition struct {
+	Type string `json:"type"`
+	// Status is True, False or Unknown. A missing condition is considered False.
+	//+kubebuilder:validation:Enum=True;False;Unknown
+	//+kubebuilder:default=True
+	Status metav1.ConditionStatus `json:"status,omitempty"`
+}
+
+// ResourceConditionCheck defines a resource of a managed cluster whose conditions must have the expected statuses
+type ResourceConditionCheck struct {
+	// Resource is the kind or plural name of the resource, qualified by its API group if it is not a core resource,
+	// e.g. "Deployment.apps" or "ClusterOperator.config.openshift.io"
+	Resource  string `json:"resource"`
+	Name      string `json:"name"`
+	Namespace string `json:"namespace,omitempty"`
+	//+kubebuilder:validation:MinItems=1
+	Conditions []ExpectedCondition `json:"conditions"`
+}
+
+// HealthChecksSpec defines the health checks run on a managed cluster through ManagedClusterViews
+type HealthChecksSpec struct {
+	// ClusterVersion checks that the ClusterVersion is not progressing, i.e. no platform upgrade is in progress.
+	ClusterVersion bool `json:"clusterVersion,omitempty"`
+	// ClusterVersionAvailable checks that the ClusterVersion is available and not failing. The ClusterOperators
+	// are not checked individually, only through what the ClusterVersion reports: list them in resources to check
+	// that each of them is available and not degraded.
+	ClusterVersionAvailable bool `json:"clusterVersionAvailable,omitempty"`
+	// MachineConfigPools checks that no MachineConfigPool of machineConfigPoolNames is updating or degraded.
+	MachineConfigPools bool `json:"machineConfigPools,omitempty"`
+	// Nodes checks that all the machines of the MachineConfigPools of machineConfigPoolNames are ready.
+	Nodes bool `json:"nodes,omitempty"`
+	// Etcd checks that the etcd ClusterOperator is available and not degraded.
+	Etcd bool `json:"etcd,omitempty"`
+	// MachineConfigPoolNames are the MachineConfigPools used by the machineConfigPools and nodes checks.
+	// Defaults to master and worker.
+	MachineConfigPoolNames []string `json:"machineConfigPoolNames,omitempty"`
+	// Resources are additional resources whose conditions must have the expected statuses.
+	Resources []ResourceConditionCheck `json:"resources,omitempty"`
+}
+
 // RemediationStrategySpec defines the remediation policy
 type RemediationStrategySpec struct {
 	// Canaries defines the list of managed clusters that should be remediated first when remediateAction is set to enforce
 	Canaries []string `json:"canaries,omitempty"`
+	// OnCanaryFailure is what happens when the canary batches time out. Stop, the default, ends the CGU. Rollback
+	// also enforces the rollbackPolicies on the canary clusters before ending it. Rollback is only supported when
+	// remediating managedPolicies.
+	//+kubebuilder:validation:Enum=Stop;Rollback
+	//+operator-sdk:csv:customresourcedefinitions:type=spec,displayName="On Canary Failure",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
+	OnCanaryFailure string `json:"onCanaryFailure,omitempty"`
+	// RollbackPolicies are the inform policies enforced on the canary clusters when onCanaryFailure is Rollback,
+	// e.g. policies setting the operator subscriptions back to their previous channels. Like managedPolicies,
+	// they must be bound to the canary clusters.
+	//+operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Rollback Policies",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
+	RollbackPolicies []string `json:"rollbackPolicies,omitempty"`
+	// Mode is how the clusters following the canaries are remediated. In Batch mode (the default), they are
+	// split into batches of maxConcurrency clusters and a batch only starts once the previous one is done.
+	// In Rolling mode, up to maxConcurrency clusters are remediated at any time and the next cluster starts
+	// as soon as one is done. Rolling cannot be used together with batchSizes, spreadBy and batchBy.
+	//+kubebuilder:validation:Enum=Batch;Rolling
+	//+operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Mode",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
+	Mode string `json:"mode,omitempty"`
 	//kubebuilder:validation:Minimum=1
 	MaxConcurrency int `json:"maxConcurrency"`
+	// MaxConcurrencyPercentage is the maximum number of clusters remediated at the same time as a percentage of
+	// the selected clusters, rounded up. When set, it takes precedence over maxConcurrency.
+	//+kubebuilder:validation:Minimum=1
+	//+kubebuilder:validation:Maximum=100
+	//+operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Max Concurrency Percentage",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:number"}
+	MaxConcurrencyPercentage int `json:"maxConcurrencyPercentage,omitempty"`
+	// BatchSizes enables a progressive rollout where batches start small and grow as confidence grows,
+	// e.g. [1, 5, "25%", "50%"]. Each entry is the size of one batch, either a number of clusters or a
+	// percentage of the selected clusters (rounded up). The batches follow the canaries, and any clusters
+	// left once the list is exhausted are remediated in batches of maxConcurrency.
+	//+operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Batch Sizes",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
+	BatchSizes []intstr.IntOrString `json:"batchSizes,omitempty"`
+	// SpreadBy guarantees that no batch contains more than maxPerBatch clusters with the same value of the
+	// given ManagedCluster label. Clusters without the label are not restricted. Batches that cannot be
+	// filled without breaking that rule are started with fewer clusters. Cannot be used together with BatchBy.
+	//+operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Spread By",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
+	SpreadBy *SpreadBySpec `json:"spreadBy,omitempty"`
+	// BatchBy is a ManagedCluster label key used to remediate whole topology domains together: all the
+	// clusters with the same label value are put in the same batch, and several domains share a batch
+	// as long as it does not grow over its size. A domain bigger than the batch size gets a batch of its own.
+	// Clusters without the label are batched after the labeled ones. Cannot be used together with SpreadBy.
+	//+operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Batch By",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
+	BatchBy string `json:"batchBy,omitempty"`
+	// UnavailableClusters checks that the clusters of a batch are available when the batch starts, and skips or
+	// defers the unavailable ones instead of letting them time out. Unset means the clusters are not checked.
+	//+operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Unavailable Clusters",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
+	UnavailableClusters *UnavailableClustersSpec `json:"unavailableClusters,omitempty"`
 	//+kubebuilder:default=240
 	Timeout int `json:"timeout,omitempty"`
+	// ClusterTimeout is the number of minutes a cluster is given to complete its remediation once started. A cluster
+	// that has not completed in time is marked timed out and removed from its batch, so that the rest of the batch
+	// does not wait for it. Unset means the clusters are only bound by the batch timeout.
+	//+kubebuilder:validation:Minimum=0
+	//+operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Cluster Timeout",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
+	ClusterTimeout int `json:"clusterTimeout,omitempty"`
+	// MaxFailures is the number (e.g. 3) or percentage (e.g. "10%") of the clusters in the remediation plan
+	// that are allowed to time out across all batches. Once more clusters than that have timed out, the CGU
+	// is stopped instead of moving on to the next batch. Percentages are rounded down. Unset means no limit.
+	//+kubebuilder:validation:XIntOrString
+	//+operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Max Failures",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
+	MaxFailures *intstr.IntOrString `json:"maxFailures,omitempty"`
+}
+
+// PolicyOverride defines the settings of a managed policy for a CGU
+type PolicyOverride struct {
+	// Name is the name of the managed policy
+	//+kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+	// SoakSeconds is the least number of seconds a cluster must stay compliant with the policy before moving on
+	// to the next one. It overrides the ran.openshift.io/soak-seconds annotation of the policy.
+	//+kubebuilder:validation:Minimum=0
+	SoakSeconds *int `json:"soakSeconds,omitempty"`
+	// Timeout is the number of minutes a cluster is given to become compliant with the policy, soak time included.
+	// A cluster that has not moved on to the next policy in time is marked timed out and removed from its batch.
+	//+kubebuilder:validation:Minimum=1
+	Timeout *int `json:"timeout,omitempty"`
+	// SkipIfCompliant controls whether the policy is left out of the remediation when all the clusters are
+	// already compliant with it. By default, it is left out unless its objects check status fields, since their
+	// compliance may change as the previous policies are enforced.
+	SkipIfCompliant *bool `json:"skipIfCompliant,omitempty"`
+}
+
+// RemediationMode selections
+var RemediationMode = struct {
+	Batch   string
+	Rolling string
+}{
+	Batch:   "Batch",
+	Rolling: "Rolling",
+}
+
+// OnCanaryFailureAction selections
+var OnCanaryFailureAction = struct {
+	Stop     string
+	Rollback string
+}{
+	Stop:     "Stop",
+	Rollback: "Rollback",
 }
 
 // NamespacedCR defines the name and namespace of a custom resource
@@ -102,6 +283,15 @@ var BatchTimeoutAction = struct {
 	Abort:    "Abort",
 }
 
+// ConflictAction selections
+var ConflictAction = struct {
+	Wait                       string
+	WaitForOverlappingClusters string
+}{
+	Wait:                       "Wait",
+	WaitForOverlappingClusters: "WaitForOverlappingClusters",
+}
+
 // OperatorUpgradeSpec defines the configuration of an operator upgrade
 type OperatorUpgradeSpec struct {
 	Channel   string `json:"channel,omitempty"`
@@ -109,8 +299,6 @@ type OperatorUpgradeSpec struct {
 	Namespace string `json:"namespace,omitempty"`
 }
 
-// TODO add validation so manifest templates and managed policies are not used at the same time
-
 // ClusterGroupUpgradeSpec defines the desired state of ClusterGroupUpgrade
 type ClusterGroupUpgradeSpec struct {
 	// INSERT ADDITIONAL SPEC FIELDS - desired state of cluster
@@ -134,11 +322,54 @@ type ClusterGroupUpgradeSpec struct {
 	// pre-caching configurations.
 	//+operator-sdk:csv:customresourcedefinitions:type=spec,displayName="PreCachingConfigRef",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
 	PreCachingConfigRef PreCachingConfigCR `json:"preCachingConfigRef,omitempty"`
+	// MaintenanceWindows restricts when new batches can start. Outside of the windows, the CGU waits
+	// for the next window to open and the time spent waiting is not counted against the timeouts.
+	// Batches already started keep running when a window closes. Clusters can also have their own
+	// windows in the ran.openshift.io/maintenance-windows ManagedCluster annotation, in which case
+	// their remediation only starts inside them.
+	//+operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Maintenance Windows",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
+	MaintenanceWindows []MaintenanceWindow `json:"maintenanceWindows,omitempty"`
+	// PreflightChecks are health checks run on each cluster right before its remediation starts. The remediation
+	// waits for the results of the checks, and the clusters failing any of them are skipped and reported with the
+	// preflightfailed state and the reason of the failure.
+	//+operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Preflight Checks",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
+	PreflightChecks *HealthChecksSpec `json:"preflightChecks,omitempty"`
+	// Verification are health checks run on each cluster once it is compliant with all the managed policies or
+	// all its manifestWorkTemplates are applied. The cluster is only marked as completed, and the afterCompletion
+	// actions taken, once the checks have passed. A cluster that does not pass them stays in progress and eventually
+	// times out.
+	//+operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Verification",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
+	Verification *HealthChecksSpec `json:"verification,omitempty"`
 	// This field determines when the CGU starts. While false, the CGU doesn't start.
 	// Once set to true, policy rollout starts on the clusters, one batch at a time.
+	// Setting it back to false while the CGU is in progress pauses the rollout: no new
+	// batches or clusters are remediated and the timeout clock is stopped until it is
+	// set to true again.
 	//+kubebuilder:default=true
 	//+operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Enable",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:bool"}
 	Enable *bool `json:"enable,omitempty"`
+	// StartAt schedules the start of the CGU at the given time (RFC3339). Until then, the CGU waits as if
+	// it was not enabled, but pre-caching is still done ahead of time. It has no effect while enable is false.
+	//+kubebuilder:validation:Format=date-time
+	//+operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Start At",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
+	StartAt *metav1.Time `json:"startAt,omitempty"`
+	// DryRun computes the plan of the CGU and reports it in status.dryRun without remediating any cluster:
+	// no Placements, PlacementBindings, ManifestWorks, ManagedClusterActions or pre-caching jobs are created.
+	// Setting it back to false lets the CGU start as usual.
+	//+operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Dry Run",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:bool"}
+	DryRun bool `json:"dryRun,omitempty"`
+	// DynamicMembership keeps the cluster selection up to date while the CGU is in progress. Newly selected clusters
+	// that need remediation are appended to the remaining batches, and clusters whose ManagedCluster is deleted are
+	// removed from the remaining batches and reported with the removed state instead of timing out.
+	//+operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Dynamic Membership",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:bool"}
+	DynamicMembership bool `json:"dynamicMembership,omitempty"`
+	// RetryOf references a completed ClusterGroupUpgrade whose timed out clusters are remediated again by this one.
+	// It cannot be used together with clusters, clusterSelector and clusterLabelSelectors. When managedPolicies
+	// and manifestWorkTemplates are both empty, the ones of the referenced ClusterGroupUpgrade are used and
+	// recorded in status.inheritedManagedPolicies and status.inheritedManifestWorkTemplates.
+	// The namespace defaults to the namespace of this ClusterGroupUpgrade.
+	//+operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Retry Of",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
+	RetryOf *NamespacedCR `json:"retryOf,omitempty"`
 	//+operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Clusters",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
 	Clusters []string `json:"clusters,omitempty"`
 	// This field holds a label common to multiple clusters that will be updated.
@@ -181,11 +412,28 @@ type ClusterGroupUpgradeSpec struct {
 	//+kubebuilder:validation:Optional
 	//+operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Managed Policies",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
 	ManagedPolicies []string `json:"managedPolicies,omitempty"`
+	// PolicyOverrides are settings of managedPolicies that only apply to this CGU and take precedence over the
+	// ones declared on the policies, e.g. the ran.openshift.io/soak-seconds annotation.
+	//+operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Policy Overrides",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
+	PolicyOverrides []PolicyOverride `json:"policyOverrides,omitempty"`
 	//+operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Manifest Work Templates",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
 	//+kubebuilder:validation:Optional
 	ManifestWorkTemplates []string `json:"manifestWorkTemplates"`
 	//+operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Blocking CRs",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
 	BlockingCRs []BlockingCR `json:"blockingCRs,omitempty"`
+	// Priority orders the active ClusterGroupUpgrades remediating some of the same clusters: the one with the higher
+	// priority goes first, and the ones with the same priority go in creation order. A ClusterGroupUpgrade in progress
+	// is never preempted. The default value is 0.
+	//+operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Priority",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:number"}
+	Priority int `json:"priority,omitempty"`
+	// The Conflict Action controls what waits for the conflicting ClusterGroupUpgrades, i.e. the active ones remediating
+	// some of the same clusters that are in progress or go first. The default value is `Wait`.
+	// The possible values are:
+	//   - Wait: the ClusterGroupUpgrade doesn't start until the conflicting ones are done with the overlapping clusters
+	//   - WaitForOverlappingClusters: the ClusterGroupUpgrade starts and only the overlapping clusters wait
+	//+kubebuilder:validation:Enum=Wait;WaitForOverlappingClusters
+	//+operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Conflict Action",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
+	ConflictAction string `json:"conflictAction,omitempty"`
 	//+operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Actions",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
 	Actions Actions `json:"actions,omitempty"`
 	// The Batch Timeout Action can be specified to control what happens when a batch times out. The default value is `Continue`.
@@ -223,6 +471,10 @@ type ClusterRemediationProgress struct {
 	ManifestWorkIndex *int        `json:"manifestWorkIndex,omitempty"`
 	PolicyIndex       *int        `json:"policyIndex,omitempty"`
 	FirstCompliantAt  metav1.Time `json:"firstCompliantAt,omitempty"`
+	// StartedAt is set when the remediation of the cluster starts.
+	StartedAt *metav1.Time `json:"startedAt,omitempty"`
+	// PolicyStartedAt is set when the cluster moves on to the policy at PolicyIndex.
+	PolicyStartedAt *metav1.Time `json:"policyStartedAt,omitempty"`
 }
 
 // ClusterRemediationProgress possible states
@@ -238,6 +490,10 @@ type UpgradeStatus struct {
 	CompletedAt           metav1.Time `json:"completedAt,omitempty"`
 	CurrentBatch          int         `json:"currentBatch,omitempty"`
 	CurrentBatchStartedAt metav1.Time `json:"currentBatchStartedAt,omitempty"`
+	// PausedAt is set when an in-progress CGU is paused by setting spec.enable to false.
+	PausedAt *metav1.Time `json:"pausedAt,omitempty"`
+	// WaitingForMaintenanceWindowSince is set while the next batch waits for a maintenance window to open.
+	WaitingForMaintenanceWindowSince *metav1.Time `json:"waitingForMaintenanceWindowSince,omitempty"`
 
 	CurrentBatchRemediationProgress map[string]*ClusterRemediationProgress `json:"currentBatchRemediationProgress,omitempty"`
 }
@@ -264,6 +520,7 @@ type ManifestWorkStatus struct {
 type ClusterState struct {
 	Name                string              `json:"name"`
 	State               string              `json:"state"`
+	Reason              string              `json:"reason,omitempty"`
 	CurrentPolicy       *PolicyStatus       `json:"currentPolicy,omitempty"`
 	CurrentManifestWork *ManifestWorkStatus `json:"currentManifestWork,omitempty"`
 }
@@ -287,6 +544,52 @@ type PrecachingStatus struct {
 }
 
 // BackupStatus defines the observed backup status
+// DryRunStatus holds the plan computed for a CGU in dry run mode
+type DryRunStatus struct {
+	ComputedAt metav1.Time `json:"computedAt,omitempty"`
+	// All the clusters selected by the CGU
+	SelectedClusters []string `json:"selectedClusters,omitempty"`
+	// The selected clusters that are already compliant and would not be remediated
+	CompliantClusters []string `json:"compliantClusters,omitempty"`
+	// The batches in which the clusters would be remediated
+	Batches [][]string `json:"batches,omitempty"`
+	// The remediation plan of each cluster to remediate
+	Clusters []DryRunClusterPlan `json:"clusters,omitempty"`
+}
+
+// DryRunClusterPlan holds what would be remediated on a cluster
+type DryRunClusterPlan struct {
+	Name string `json:"name"`
+	// Index of the batch the cluster is in
+	BatchIndex int `json:"batchIndex"`
+	// The managed policies the cluster is NonCompliant with, in the order they would be enforced
+	NonCompliantPolicies []string `json:"nonCompliantPolicies,omitempty"`
+	// The subscriptions (namespace/name) in those policies whose InstallPlans would be approved
+	SubscriptionsToApprove []string `json:"subscriptionsToApprove,omitempty"`
+}
+
+// RollbackStatus defines the observed state of the rollback of the canary clusters
+type RollbackStatus struct {
+	StartedAt   metav1.Time  `json:"startedAt,omitempty"`
+	CompletedAt *metav1.Time `json:"completedAt,omitempty"`
+	// The canary clusters being rolled back
+	Clusters []string `json:"clusters,omitempty"`
+	// The rollback policies enforced on the canary clusters
+	Policies []ManagedPolicyForUpgrade `json:"policies,omitempty"`
+}
+
+// RollingStatus defines the observed progress of a Rolling remediation
+type RollingStatus struct {
+	// The clusters being remediated
+	InFlightClusters []string `json:"inFlightClusters,omitempty"`
+	// The number of clusters waiting for a free slot
+	QueuedClusters int `json:"queuedClusters"`
+	// The number of clusters that completed their remediation
+	CompletedClusters int `json:"completedClusters"`
+	// The number of clusters that timed out or failed their preflight checks
+	FailedClusters int `json:"failedClusters"`
+}
+
 type BackupStatus struct {
 	StartedAt metav1.Time       `json:"startedAt,omitempty"`
 	Status    map[string]string `json:"status,omitempty"`
@@ -331,10 +634,29 @@ type ClusterGroupUpgradeStatus struct {
 	Backup *BackupStatus `json:"backup,omitempty"`
 	// +operator-sdk:csv:customresourcedefinitions:type=status,displayName="Computed Maximum Concurrency"
 	ComputedMaxConcurrency int `json:"computedMaxConcurrency,omitempty"`
+	// Number of times each cluster has been retried through spec.retryOf
+	// +operator-sdk:csv:customresourcedefinitions:type=status,displayName="Cluster Retry Counts"
+	ClusterRetryCounts map[string]int `json:"clusterRetryCounts,omitempty"`
+	// The managed policies used from spec.retryOf since the spec doesn't set any
+	// +operator-sdk:csv:customresourcedefinitions:type=status,displayName="Inherited Managed Policies"
+	InheritedManagedPolicies []string `json:"inheritedManagedPolicies,omitempty"`
+	// The manifestwork templates used from spec.retryOf since the spec doesn't set any
+	// +operator-sdk:csv:customresourcedefinitions:type=status,displayName="Inherited ManifestWork Templates"
+	InheritedManifestWorkTemplates []string `json:"inheritedManifestWorkTemplates,omitempty"`
+	// The plan computed when spec.dryRun is set
+	// +operator-sdk:csv:customresourcedefinitions:type=status,displayName="Dry Run"
+	DryRun *DryRunStatus `json:"dryRun,omitempty"`
+	// The rollback of the canary clusters when remediationStrategy.onCanaryFailure is Rollback
+	// +operator-sdk:csv:customresourcedefinitions:type=status,displayName="Rollback"
+	Rollback *RollbackStatus `json:"rollback,omitempty"`
+	// The progress of the remediation when remediationStrategy.mode is Rolling
+	// +operator-sdk:csv:customresourcedefinitions:type=status,displayName="Rolling"
+	Rolling *RollingStatus `json:"rolling,omitempty"`
 }
 
 // +genclient
 //+kubebuilder:object:root=true
+//+kubebuilder:storageversion
 //+kubebuilder:subresource:status
 //+kubebuilder:resource:path=clustergroupupgrades,shortName=cgu
 //+kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
@@ -391,6 +713,7 @@ type PreCachingConfigSpec struct {
 }
 
 //+kubebuilder:object:root=true
+//+kubebuilder:storageversion
 
 // PreCachingConfig is the Schema for the precachingconfigs API
 type PreCachingConfig struct {
@@ -408,3 +731,168 @@ type PreCachingConfigList struct {
 	metav1.ListMeta `json:"metadata,omitempty"`
 	Items           []PreCachingConfig `json:"items"`
 }
+
+// ConcurrencyGroup caps the clusters of a label group being remediated at the same time
+type ConcurrencyGroup struct {
+	// Name of the group, used in the status messages
+	//+kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+	// Selects the ManagedClusters of the group by their labels
+	Selector metav1.LabelSelector `json:"selector"`
+	// Maximum number of clusters of the group remediated at the same time across all the ClusterGroupUpgrades
+	//+kubebuilder:validation:Minimum=1
+	MaxConcurrentClusters int `json:"maxConcurrentClusters"`
+}
+
+// UpgradeConcurrencyPolicySpec defines the desired state of UpgradeConcurrencyPolicy
+type UpgradeConcurrencyPolicySpec struct {
+	// Maximum number of clusters remediated at the same time across all the ClusterGroupUpgrades, 0 means no limit
+	//+kubebuilder:validation:Minimum=0
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Maximum Concurrent Clusters",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:number"}
+	MaxConcurrentClusters int `json:"maxConcurrentClusters,omitempty"`
+	// Additional limits for groups of clusters selected by their labels
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Groups",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
+	Groups []ConcurrencyGroup `json:"groups,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+//+kubebuilder:resource:path=upgradeconcurrencypolicies,scope=Cluster,shortName=ucp
+//+kubebuilder:printcolumn:name="Max Concurrent Clusters",type="integer",JSONPath=".spec.maxConcurrentClusters"
+//+kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// UpgradeConcurrencyPolicy caps the number of clusters remediated at the same time across all the ClusterGroupUpgrades
+// +operator-sdk:csv:customresourcedefinitions:displayName="Upgrade Concurrency Policy"
+type UpgradeConcurrencyPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec UpgradeConcurrencyPolicySpec `json:"spec,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// UpgradeConcurrencyPolicyList contains a list of UpgradeConcurrencyPolicy
+type UpgradeConcurrencyPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []UpgradeConcurrencyPolicy `json:"items"`
+}
+
+// UpgradeAlertsConfigSpec defines the thresholds of the alerts of the ClusterGroupUpgrades
+type UpgradeAlertsConfigSpec struct {
+	// Percentage of its timeout after which a ClusterGroupUpgrade still progressing is reported
+	//+kubebuilder:default=80
+	//+kubebuilder:validation:Minimum=1
+	//+kubebuilder:validation:Maximum=100
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Progressing Timeout Percent",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:number"}
+	ProgressingTimeoutPercent int `json:"progressingTimeoutPercent,omitempty"`
+	// Number of clusters of a ClusterGroupUpgrade whose pre-caching can fail before it is reported
+	//+kubebuilder:default=0
+	//+kubebuilder:validation:Minimum=0
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Precaching Failed Clusters",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:number"}
+	PrecachingFailedClusters int `json:"precachingFailedClusters,omitempty"`
+	// Number of minutes a cluster can stay at the same managed policy before it is reported as stuck
+	//+kubebuilder:default=60
+	//+kubebuilder:validation:Minimum=1
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Stuck Policy Minutes",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:number"}
+	StuckPolicyMinutes int `json:"stuckPolicyMinutes,omitempty"`
+	// Additional labels of the PrometheusRule, e.g. to match the rule selector of the Prometheus instance
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Rule Labels",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
+	RuleLabels map[string]string `json:"ruleLabels,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+//+kubebuilder:resource:path=upgradealertsconfigs,scope=Cluster,shortName=uac
+//+kubebuilder:validation:XValidation:rule="self.metadata.name == 'default'",message="the UpgradeAlertsConfig must be named default"
+//+kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// UpgradeAlertsConfig makes the operator create and own a PrometheusRule alerting on stuck or failing ClusterGroupUpgrades
+// +operator-sdk:csv:customresourcedefinitions:displayName="Upgrade Alerts Config"
+type UpgradeAlertsConfig struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec UpgradeAlertsConfigSpec `json:"spec,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// UpgradeAlertsConfigList contains a list of UpgradeAlertsConfig
+type UpgradeAlertsConfigList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []UpgradeAlertsConfig `json:"items"`
+}
+
+// NotificationFormat is the format of the body of the notifications
+// +kubebuilder:validation:Enum=CloudEvents;JSON
+type NotificationFormat string
+
+// Formats of the notifications
+const (
+	// NotificationFormatCloudEvents sends a CloudEvent in structured content mode
+	NotificationFormatCloudEvents NotificationFormat = "CloudEvents"
+	// NotificationFormatJSON sends the event as a plain JSON object
+	NotificationFormatJSON NotificationFormat = "JSON"
+)
+
+// NotificationSigningSecret references the Secret holding the HMAC key of the notifications
+type NotificationSigningSecret struct {
+	// Name of the Secret, in the namespace of the operator
+	//+kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+	// Key of the HMAC key in the Secret
+	//+kubebuilder:default=key
+	Key string `json:"key,omitempty"`
+}
+
+// UpgradeNotificationEndpointSpec defines the HTTP endpoint the events of the ClusterGroupUpgrades are sent to
+type UpgradeNotificationEndpointSpec struct {
+	// URL the notifications are posted to
+	//+kubebuilder:validation:Pattern=`^https?://`
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="URL",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
+	URL string `json:"url"`
+	// Format of the body of the notifications
+	//+kubebuilder:default=CloudEvents
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Format",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:select:CloudEvents","urn:alm:descriptor:com.tectonic.ui:select:JSON"}
+	Format NotificationFormat `json:"format,omitempty"`
+	// Event reasons to send, e.g. CguStarted or CguTimedout. All the reasons are sent if empty
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Reasons",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
+	Reasons []string `json:"reasons,omitempty"`
+	// Event actions to send, e.g. RemediationInBatchCompleted. All the actions are sent if empty
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Actions",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
+	Actions []string `json:"actions,omitempty"`
+	// Secret whose key signs the body of the notifications with HMAC-SHA256, the signature is not sent if unset
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Signing Secret"
+	SigningSecret *NotificationSigningSecret `json:"signingSecret,omitempty"`
+	// Number of retries of a notification when the endpoint is unreachable or answers with a 429 or 5xx status code
+	//+kubebuilder:default=3
+	//+kubebuilder:validation:Minimum=0
+	//+kubebuilder:validation:Maximum=10
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Max Retries",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:number"}
+	MaxRetries int `json:"maxRetries,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+//+kubebuilder:resource:path=upgradenotificationendpoints,scope=Cluster,shortName=une
+//+kubebuilder:printcolumn:name="URL",type="string",JSONPath=".spec.url"
+//+kubebuilder:printcolumn:name="Format",type="string",JSONPath=".spec.format"
+//+kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// UpgradeNotificationEndpoint makes the operator send the events of the ClusterGroupUpgrades to an HTTP endpoint
+// +operator-sdk:csv:customresourcedefinitions:displayName="Upgrade Notification Endpoint"
+type UpgradeNotificationEndpoint struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec UpgradeNotificationEndpointSpec `json:"spec,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// UpgradeNotificationEndpointList contains a list of UpgradeNotificationEndpoint
+type UpgradeNotificationEndpointList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []UpgradeNotificationEndpoint `json:"items"`
+}
@@ -23,6 +23,7 @@ package v1alpha1
 import (
 	"k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
 )
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
@@ -253,11 +254,37 @@ func (in *ClusterGroupUpgradeList) DeepCopyObject() runtime.Object {
 func (in *ClusterGroupUpgradeSpec) DeepCopyInto(out *ClusterGroupUpgradeSpec) {
 	*out = *in
 	out.PreCachingConfigRef = in.PreCachingConfigRef
+	if in.MaintenanceWindows != nil {
+		in, out := &in.MaintenanceWindows, &out.MaintenanceWindows
+		*out = make([]MaintenanceWindow, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.PreflightChecks != nil {
+		in, out := &in.PreflightChecks, &out.PreflightChecks
+		*out = new(HealthChecksSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Verification != nil {
+		in, out := &in.Verification, &out.Verification
+		*out = new(HealthChecksSpec)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Enable != nil {
 		in, out := &in.Enable, &out.Enable
 		*out = new(bool)
 		**out = **in
 	}
+	if in.StartAt != nil {
+		in, out := &in.StartAt, &out.StartAt
+		*out = (*in).DeepCopy()
+	}
+	if in.RetryOf != nil {
+		in, out := &in.RetryOf, &out.RetryOf
+		*out = new(NamespacedCR)
+		**out = **in
+	}
 	if in.Clusters != nil {
 		in, out := &in.Clusters, &out.Clusters
 		*out = make([]string, len(*in))
@@ -285,6 +312,13 @@ func (in *ClusterGroupUpgradeSpec) DeepCopyInto(out *ClusterGroupUpgradeSpec) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.PolicyOverrides != nil {
+		in, out := &in.PolicyOverrides, &out.PolicyOverrides
+		*out = make([]PolicyOverride, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.ManifestWorkTemplates != nil {
 		in, out := &in.ManifestWorkTemplates, &out.ManifestWorkTemplates
 		*out = make([]string, len(*in))
@@ -393,6 +427,38 @@ func (in *ClusterGroupUpgradeStatus) DeepCopyInto(out *ClusterGroupUpgradeStatus
 		*out = new(BackupStatus)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ClusterRetryCounts != nil {
+		in, out := &in.ClusterRetryCounts, &out.ClusterRetryCounts
+		*out = make(map[string]int, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.InheritedManagedPolicies != nil {
+		in, out := &in.InheritedManagedPolicies, &out.InheritedManagedPolicies
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.InheritedManifestWorkTemplates != nil {
+		in, out := &in.InheritedManifestWorkTemplates, &out.InheritedManifestWorkTemplates
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.DryRun != nil {
+		in, out := &in.DryRun, &out.DryRun
+		*out = new(DryRunStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Rollback != nil {
+		in, out := &in.Rollback, &out.Rollback
+		*out = new(RollbackStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Rolling != nil {
+		in, out := &in.Rolling, &out.Rolling
+		*out = new(RollingStatus)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterGroupUpgradeStatus.
@@ -419,6 +485,14 @@ func (in *ClusterRemediationProgress) DeepCopyInto(out *ClusterRemediationProgre
 		**out = **in
 	}
 	in.FirstCompliantAt.DeepCopyInto(&out.FirstCompliantAt)
+	if in.StartedAt != nil {
+		in, out := &in.StartedAt, &out.StartedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.PolicyStartedAt != nil {
+		in, out := &in.PolicyStartedAt, &out.PolicyStartedAt
+		*out = (*in).DeepCopy()
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterRemediationProgress.
@@ -456,6 +530,154 @@ func (in *ClusterState) DeepCopy() *ClusterState {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConcurrencyGroup) DeepCopyInto(out *ConcurrencyGroup) {
+	*out = *in
+	in.Selector.DeepCopyInto(&out.Selector)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConcurrencyGroup.
+func (in *ConcurrencyGroup) DeepCopy() *ConcurrencyGroup {
+	if in == nil {
+		return nil
+	}
+	out := new(ConcurrencyGroup)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DryRunClusterPlan) DeepCopyInto(out *DryRunClusterPlan) {
+	*out = *in
+	if in.NonCompliantPolicies != nil {
+		in, out := &in.NonCompliantPolicies, &out.NonCompliantPolicies
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.SubscriptionsToApprove != nil {
+		in, out := &in.SubscriptionsToApprove, &out.SubscriptionsToApprove
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DryRunClusterPlan.
+func (in *DryRunClusterPlan) DeepCopy() *DryRunClusterPlan {
+	if in == nil {
+		return nil
+	}
+	out := new(DryRunClusterPlan)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DryRunStatus) DeepCopyInto(out *DryRunStatus) {
+	*out = *in
+	in.ComputedAt.DeepCopyInto(&out.ComputedAt)
+	if in.SelectedClusters != nil {
+		in, out := &in.SelectedClusters, &out.SelectedClusters
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.CompliantClusters != nil {
+		in, out := &in.CompliantClusters, &out.CompliantClusters
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Batches != nil {
+		in, out := &in.Batches, &out.Batches
+		*out = make([][]string, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = make([]string, len(*in))
+				copy(*out, *in)
+			}
+		}
+	}
+	if in.Clusters != nil {
+		in, out := &in.Clusters, &out.Clusters
+		*out = make([]DryRunClusterPlan, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DryRunStatus.
+func (in *DryRunStatus) DeepCopy() *DryRunStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DryRunStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExpectedCondition) DeepCopyInto(out *ExpectedCondition) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExpectedCondition.
+func (in *ExpectedCondition) DeepCopy() *ExpectedCondition {
+	if in == nil {
+		return nil
+	}
+	out := new(ExpectedCondition)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HealthChecksSpec) DeepCopyInto(out *HealthChecksSpec) {
+	*out = *in
+	if in.MachineConfigPoolNames != nil {
+		in, out := &in.MachineConfigPoolNames, &out.MachineConfigPoolNames
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = make([]ResourceConditionCheck, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HealthChecksSpec.
+func (in *HealthChecksSpec) DeepCopy() *HealthChecksSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(HealthChecksSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MaintenanceWindow) DeepCopyInto(out *MaintenanceWindow) {
+	*out = *in
+	if in.DaysOfWeek != nil {
+		in, out := &in.DaysOfWeek, &out.DaysOfWeek
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	out.Duration = in.Duration
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MaintenanceWindow.
+func (in *MaintenanceWindow) DeepCopy() *MaintenanceWindow {
+	if in == nil {
+		return nil
+	}
+	out := new(MaintenanceWindow)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ManagedPolicyForUpgrade) DeepCopyInto(out *ManagedPolicyForUpgrade) {
 	*out = *in
@@ -502,6 +724,21 @@ func (in *NamespacedCR) DeepCopy() *NamespacedCR {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NotificationSigningSecret) DeepCopyInto(out *NotificationSigningSecret) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NotificationSigningSecret.
+func (in *NotificationSigningSecret) DeepCopy() *NotificationSigningSecret {
+	if in == nil {
+		return nil
+	}
+	out := new(NotificationSigningSecret)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *OperatorUpgradeSpec) DeepCopyInto(out *OperatorUpgradeSpec) {
 	*out = *in
@@ -542,6 +779,36 @@ func (in *PlatformPreCachingSpec) DeepCopy() *PlatformPreCachingSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PolicyOverride) DeepCopyInto(out *PolicyOverride) {
+	*out = *in
+	if in.SoakSeconds != nil {
+		in, out := &in.SoakSeconds, &out.SoakSeconds
+		*out = new(int)
+		**out = **in
+	}
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(int)
+		**out = **in
+	}
+	if in.SkipIfCompliant != nil {
+		in, out := &in.SkipIfCompliant, &out.SkipIfCompliant
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PolicyOverride.
+func (in *PolicyOverride) DeepCopy() *PolicyOverride {
+	if in == nil {
+		return nil
+	}
+	out := new(PolicyOverride)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PolicyStatus) DeepCopyInto(out *PolicyStatus) {
 	*out = *in
@@ -731,6 +998,31 @@ func (in *RemediationStrategySpec) DeepCopyInto(out *RemediationStrategySpec) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.RollbackPolicies != nil {
+		in, out := &in.RollbackPolicies, &out.RollbackPolicies
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.BatchSizes != nil {
+		in, out := &in.BatchSizes, &out.BatchSizes
+		*out = make([]intstr.IntOrString, len(*in))
+		copy(*out, *in)
+	}
+	if in.SpreadBy != nil {
+		in, out := &in.SpreadBy, &out.SpreadBy
+		*out = new(SpreadBySpec)
+		**out = **in
+	}
+	if in.UnavailableClusters != nil {
+		in, out := &in.UnavailableClusters, &out.UnavailableClusters
+		*out = new(UnavailableClustersSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MaxFailures != nil {
+		in, out := &in.MaxFailures, &out.MaxFailures
+		*out = new(intstr.IntOrString)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RemediationStrategySpec.
@@ -743,12 +1035,373 @@ func (in *RemediationStrategySpec) DeepCopy() *RemediationStrategySpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceConditionCheck) DeepCopyInto(out *ResourceConditionCheck) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]ExpectedCondition, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceConditionCheck.
+func (in *ResourceConditionCheck) DeepCopy() *ResourceConditionCheck {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceConditionCheck)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RollbackStatus) DeepCopyInto(out *RollbackStatus) {
+	*out = *in
+	in.StartedAt.DeepCopyInto(&out.StartedAt)
+	if in.CompletedAt != nil {
+		in, out := &in.CompletedAt, &out.CompletedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.Clusters != nil {
+		in, out := &in.Clusters, &out.Clusters
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Policies != nil {
+		in, out := &in.Policies, &out.Policies
+		*out = make([]ManagedPolicyForUpgrade, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RollbackStatus.
+func (in *RollbackStatus) DeepCopy() *RollbackStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(RollbackStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RollingStatus) DeepCopyInto(out *RollingStatus) {
+	*out = *in
+	if in.InFlightClusters != nil {
+		in, out := &in.InFlightClusters, &out.InFlightClusters
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RollingStatus.
+func (in *RollingStatus) DeepCopy() *RollingStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(RollingStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SpreadBySpec) DeepCopyInto(out *SpreadBySpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SpreadBySpec.
+func (in *SpreadBySpec) DeepCopy() *SpreadBySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(SpreadBySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UnavailableClustersSpec) DeepCopyInto(out *UnavailableClustersSpec) {
+	*out = *in
+	if in.MaxLeaseAge != nil {
+		in, out := &in.MaxLeaseAge, &out.MaxLeaseAge
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UnavailableClustersSpec.
+func (in *UnavailableClustersSpec) DeepCopy() *UnavailableClustersSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(UnavailableClustersSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UpgradeAlertsConfig) DeepCopyInto(out *UpgradeAlertsConfig) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UpgradeAlertsConfig.
+func (in *UpgradeAlertsConfig) DeepCopy() *UpgradeAlertsConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(UpgradeAlertsConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *UpgradeAlertsConfig) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UpgradeAlertsConfigList) DeepCopyInto(out *UpgradeAlertsConfigList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]UpgradeAlertsConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UpgradeAlertsConfigList.
+func (in *UpgradeAlertsConfigList) DeepCopy() *UpgradeAlertsConfigList {
+	if in == nil {
+		return nil
+	}
+	out := new(UpgradeAlertsConfigList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *UpgradeAlertsConfigList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UpgradeAlertsConfigSpec) DeepCopyInto(out *UpgradeAlertsConfigSpec) {
+	*out = *in
+	if in.RuleLabels != nil {
+		in, out := &in.RuleLabels, &out.RuleLabels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UpgradeAlertsConfigSpec.
+func (in *UpgradeAlertsConfigSpec) DeepCopy() *UpgradeAlertsConfigSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(UpgradeAlertsConfigSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UpgradeConcurrencyPolicy) DeepCopyInto(out *UpgradeConcurrencyPolicy) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UpgradeConcurrencyPolicy.
+func (in *UpgradeConcurrencyPolicy) DeepCopy() *UpgradeConcurrencyPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(UpgradeConcurrencyPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *UpgradeConcurrencyPolicy) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UpgradeConcurrencyPolicyList) DeepCopyInto(out *UpgradeConcurrencyPolicyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]UpgradeConcurrencyPolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UpgradeConcurrencyPolicyList.
+func (in *UpgradeConcurrencyPolicyList) DeepCopy() *UpgradeConcurrencyPolicyList {
+	if in == nil {
+		return nil
+	}
+	out := new(UpgradeConcurrencyPolicyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *UpgradeConcurrencyPolicyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UpgradeConcurrencyPolicySpec) DeepCopyInto(out *UpgradeConcurrencyPolicySpec) {
+	*out = *in
+	if in.Groups != nil {
+		in, out := &in.Groups, &out.Groups
+		*out = make([]ConcurrencyGroup, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UpgradeConcurrencyPolicySpec.
+func (in *UpgradeConcurrencyPolicySpec) DeepCopy() *UpgradeConcurrencyPolicySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(UpgradeConcurrencyPolicySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UpgradeNotificationEndpoint) DeepCopyInto(out *UpgradeNotificationEndpoint) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UpgradeNotificationEndpoint.
+func (in *UpgradeNotificationEndpoint) DeepCopy() *UpgradeNotificationEndpoint {
+	if in == nil {
+		return nil
+	}
+	out := new(UpgradeNotificationEndpoint)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *UpgradeNotificationEndpoint) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UpgradeNotificationEndpointList) DeepCopyInto(out *UpgradeNotificationEndpointList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]UpgradeNotificationEndpoint, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UpgradeNotificationEndpointList.
+func (in *UpgradeNotificationEndpointList) DeepCopy() *UpgradeNotificationEndpointList {
+	if in == nil {
+		return nil
+	}
+	out := new(UpgradeNotificationEndpointList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *UpgradeNotificationEndpointList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UpgradeNotificationEndpointSpec) DeepCopyInto(out *UpgradeNotificationEndpointSpec) {
+	*out = *in
+	if in.Reasons != nil {
+		in, out := &in.Reasons, &out.Reasons
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Actions != nil {
+		in, out := &in.Actions, &out.Actions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.SigningSecret != nil {
+		in, out := &in.SigningSecret, &out.SigningSecret
+		*out = new(NotificationSigningSecret)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UpgradeNotificationEndpointSpec.
+func (in *UpgradeNotificationEndpointSpec) DeepCopy() *UpgradeNotificationEndpointSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(UpgradeNotificationEndpointSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *UpgradeStatus) DeepCopyInto(out *UpgradeStatus) {
 	*out = *in
 	in.StartedAt.DeepCopyInto(&out.StartedAt)
 	in.CompletedAt.DeepCopyInto(&out.CompletedAt)
 	in.CurrentBatchStartedAt.DeepCopyInto(&out.CurrentBatchStartedAt)
+	if in.PausedAt != nil {
+		in, out := &in.PausedAt, &out.PausedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.WaitingForMaintenanceWindowSince != nil {
+		in, out := &in.WaitingForMaintenanceWindowSince, &out.WaitingForMaintenanceWindowSince
+		*out = (*in).DeepCopy()
+	}
 	if in.CurrentBatchRemediationProgress != nil {
 		in, out := &in.CurrentBatchRemediationProgress, &out.CurrentBatchRemediationProgress
 		*out = make(map[string]*ClusterRemediationProgress, len(*in))
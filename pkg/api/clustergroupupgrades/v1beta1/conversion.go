@@ -0,0 +1,259 @@
+package v1beta1
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	"github.com/openshift-kni/cluster-group-upgrades-operator/pkg/api/clustergroupupgrades/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"sigs.k8s.io/controller-runtime/pkg/conversion"
+)
+
+// RemovedFieldsAnnotation holds the v1alpha1 fields removed from v1beta1, so that they are not lost when a
+// ClusterGroupUpgrade or a PreCachingConfig created with v1alpha1 is updated through v1beta1
+const RemovedFieldsAnnotation = "ran.openshift.io/v1alpha1-removed-fields"
+
+// The condition types and reasons whose typos were fixed in v1beta1, by their v1alpha1 value
+var (
+	renamedConditionTypes = map[string]string{
+		"BackupSuceeded":     "BackupSucceeded",
+		"PrecachingSuceeded": "PrecachingSucceeded",
+	}
+	renamedConditionReasons = map[string]string{
+		"UnresolvableDenpendency": "UnresolvableDependency",
+	}
+)
+
+// clusterGroupUpgradeRemovedFields are the ClusterGroupUpgrade v1alpha1 spec fields removed from v1beta1.
+// The deprecated status fields are not kept since the status is only written through v1alpha1.
+type clusterGroupUpgradeRemovedFields struct {
+	Backup                             bool              `json:"backup,omitempty"`
+	ClusterSelector                    []string          `json:"clusterSelector,omitempty"`
+	BeforeEnableDeleteClusterLabels    map[string]string `json:"beforeEnableDeleteClusterLabels,omitempty"`
+	AfterCompletionDeleteClusterLabels map[string]string `json:"afterCompletionDeleteClusterLabels,omitempty"`
+	// MaxConcurrency is the v1alpha1 maxConcurrency shadowed by maxConcurrencyPercentage, which becomes the
+	// v1beta1 maxConcurrency
+	MaxConcurrency int `json:"maxConcurrency,omitempty"`
+}
+
+// preCachingConfigRemovedFields are the PreCachingConfig v1alpha1 spec fields removed from v1beta1
+type preCachingConfigRemovedFields struct {
+	PlatformImage    string   `json:"platformImage,omitempty"`
+	OperatorsIndexes []string `json:"operatorsIndexes,omitempty"`
+	PreCacheImage    string   `json:"preCacheImage,omitempty"`
+}
+
+// convertThroughJSON converts the fields that have the same JSON representation in both versions,
+// leaving out the ones that only exist in src
+func convertThroughJSON(src, dst interface{}) error {
+	data, err := json.Marshal(src)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, dst)
+}
+
+// renameConditions renames the condition types and reasons found in the given map
+func renameConditions(conditions []metav1.Condition, types, reasons map[string]string) {
+	for i := range conditions {
+		if renamed, ok := types[conditions[i].Type]; ok {
+			conditions[i].Type = renamed
+		}
+		if renamed, ok := reasons[conditions[i].Reason]; ok {
+			conditions[i].Reason = renamed
+		}
+	}
+}
+
+func reverseMap(m map[string]string) map[string]string {
+	reversed := make(map[string]string, len(m))
+	for k, v := range m {
+		reversed[v] = k
+	}
+	return reversed
+}
+
+// saveRemovedFields stores the removed fields in the annotation, unless they are all empty
+func saveRemovedFields(objectMeta *metav1.ObjectMeta, removedFields interface{}) error {
+	if reflect.ValueOf(removedFields).IsZero() {
+		return nil
+	}
+	data, err := json.Marshal(removedFields)
+	if err != nil {
+		return err
+	}
+	if objectMeta.Annotations == nil {
+		objectMeta.Annotations = make(map[string]string)
+	}
+	objectMeta.Annotations[RemovedFieldsAnnotation] = string(data)
+	return nil
+}
+
+// restoreRemovedFields reads the removed fields from the annotation and removes it
+func restoreRemovedFields(objectMeta *metav1.ObjectMeta, removedFields interface{}) error {
+	data, ok := objectMeta.Annotations[RemovedFieldsAnnotation]
+	if !ok {
+		return nil
+	}
+	delete(objectMeta.Annotations, RemovedFieldsAnnotation)
+	if len(objectMeta.Annotations) == 0 {
+		objectMeta.Annotations = nil
+	}
+	if err := json.Unmarshal([]byte(data), removedFields); err != nil {
+		return fmt.Errorf("invalid %s annotation: %w", RemovedFieldsAnnotation, err)
+	}
+	return nil
+}
+
+// ConvertTo converts this ClusterGroupUpgrade to the hub version (v1alpha1)
+func (src *ClusterGroupUpgrade) ConvertTo(dstRaw conversion.Hub) error {
+	dst := dstRaw.(*v1alpha1.ClusterGroupUpgrade)
+	src.ObjectMeta.DeepCopyInto(&dst.ObjectMeta)
+	// A percentage maxConcurrency becomes maxConcurrencyPercentage since the v1alpha1 maxConcurrency is an int
+	spec := src.Spec.DeepCopy()
+	maxConcurrencyPercentage := 0
+	if spec.RemediationStrategy != nil && spec.RemediationStrategy.MaxConcurrency.Type == intstr.String {
+		percentage, err := intstr.GetScaledValueFromIntOrPercent(&spec.RemediationStrategy.MaxConcurrency, 100, true)
+		if err != nil {
+			return fmt.Errorf("invalid maxConcurrency: %w", err)
+		}
+		maxConcurrencyPercentage = min(max(percentage, 1), 100)
+		spec.RemediationStrategy.MaxConcurrency = intstr.FromInt32(0)
+	}
+	dst.Spec = v1alpha1.ClusterGroupUpgradeSpec{}
+	if err := convertThroughJSON(spec, &dst.Spec); err != nil {
+		return err
+	}
+	dst.Status = v1alpha1.ClusterGroupUpgradeStatus{}
+	if err := convertThroughJSON(&src.Status, &dst.Status); err != nil {
+		return err
+	}
+
+	renameConditions(dst.Status.Conditions, reverseMap(renamedConditionTypes), reverseMap(renamedConditionReasons))
+	for i, clusterState := range src.Status.Clusters {
+		lastStep := clusterState.LastStep
+		if lastStep == nil {
+			continue
+		}
+		switch lastStep.Type {
+		case RolloutTypes.Policy:
+			dst.Status.Clusters[i].CurrentPolicy = &v1alpha1.PolicyStatus{Name: lastStep.Name, Status: lastStep.PolicyStatus}
+		case RolloutTypes.ManifestWork:
+			dst.Status.Clusters[i].CurrentManifestWork = &v1alpha1.ManifestWorkStatus{Name: lastStep.Name}
+			if lastStep.ManifestWorkStatus != nil {
+				lastStep.ManifestWorkStatus.DeepCopyInto(&dst.Status.Clusters[i].CurrentManifestWork.Status)
+			}
+		}
+	}
+
+	removedFields := clusterGroupUpgradeRemovedFields{}
+	if err := restoreRemovedFields(&dst.ObjectMeta, &removedFields); err != nil {
+		return err
+	}
+	dst.Spec.Backup = removedFields.Backup
+	dst.Spec.ClusterSelector = removedFields.ClusterSelector // nolint: staticcheck
+	if removedFields.BeforeEnableDeleteClusterLabels != nil {
+		if dst.Spec.Actions.BeforeEnable == nil {
+			dst.Spec.Actions.BeforeEnable = &v1alpha1.BeforeEnable{}
+		}
+		dst.Spec.Actions.BeforeEnable.DeleteClusterLabels = removedFields.BeforeEnableDeleteClusterLabels // nolint: staticcheck
+	}
+	if removedFields.AfterCompletionDeleteClusterLabels != nil {
+		if dst.Spec.Actions.AfterCompletion == nil {
+			dst.Spec.Actions.AfterCompletion = &v1alpha1.AfterCompletion{}
+		}
+		dst.Spec.Actions.AfterCompletion.DeleteClusterLabels = removedFields.AfterCompletionDeleteClusterLabels // nolint: staticcheck
+	}
+	if maxConcurrencyPercentage > 0 {
+		dst.Spec.RemediationStrategy.MaxConcurrencyPercentage = maxConcurrencyPercentage
+		dst.Spec.RemediationStrategy.MaxConcurrency = removedFields.MaxConcurrency
+	}
+	return nil
+}
+
+// ConvertFrom converts the hub version (v1alpha1) to this ClusterGroupUpgrade
+func (dst *ClusterGroupUpgrade) ConvertFrom(srcRaw conversion.Hub) error {
+	src := srcRaw.(*v1alpha1.ClusterGroupUpgrade)
+	src.ObjectMeta.DeepCopyInto(&dst.ObjectMeta)
+	dst.Spec = ClusterGroupUpgradeSpec{}
+	if err := convertThroughJSON(&src.Spec, &dst.Spec); err != nil {
+		return err
+	}
+	if src.Spec.RemediationStrategy != nil && src.Spec.RemediationStrategy.MaxConcurrencyPercentage > 0 {
+		dst.Spec.RemediationStrategy.MaxConcurrency = intstr.FromString(
+			fmt.Sprintf("%d%%", src.Spec.RemediationStrategy.MaxConcurrencyPercentage))
+	}
+	dst.Status = ClusterGroupUpgradeStatus{}
+	if err := convertThroughJSON(&src.Status, &dst.Status); err != nil {
+		return err
+	}
+
+	renameConditions(dst.Status.Conditions, renamedConditionTypes, renamedConditionReasons)
+	for i, clusterState := range src.Status.Clusters {
+		switch {
+		case clusterState.CurrentPolicy != nil:
+			dst.Status.Clusters[i].LastStep = &ClusterRemediationStep{
+				Type: RolloutTypes.Policy, Name: clusterState.CurrentPolicy.Name, PolicyStatus: clusterState.CurrentPolicy.Status}
+		case clusterState.CurrentManifestWork != nil:
+			dst.Status.Clusters[i].LastStep = &ClusterRemediationStep{
+				Type: RolloutTypes.ManifestWork, Name: clusterState.CurrentManifestWork.Name,
+				ManifestWorkStatus: clusterState.CurrentManifestWork.Status.DeepCopy()}
+		}
+	}
+
+	// nolint: staticcheck
+	removedFields := clusterGroupUpgradeRemovedFields{
+		Backup:          src.Spec.Backup,
+		ClusterSelector: src.Spec.ClusterSelector,
+	}
+	if src.Spec.Actions.BeforeEnable != nil {
+		removedFields.BeforeEnableDeleteClusterLabels = src.Spec.Actions.BeforeEnable.DeleteClusterLabels // nolint: staticcheck
+	}
+	if src.Spec.Actions.AfterCompletion != nil {
+		removedFields.AfterCompletionDeleteClusterLabels = src.Spec.Actions.AfterCompletion.DeleteClusterLabels // nolint: staticcheck
+	}
+	if src.Spec.RemediationStrategy != nil && src.Spec.RemediationStrategy.MaxConcurrencyPercentage > 0 {
+		removedFields.MaxConcurrency = src.Spec.RemediationStrategy.MaxConcurrency
+	}
+	return saveRemovedFields(&dst.ObjectMeta, removedFields)
+}
+
+// ConvertTo converts this PreCachingConfig to the hub version (v1alpha1)
+func (src *PreCachingConfig) ConvertTo(dstRaw conversion.Hub) error {
+	dst := dstRaw.(*v1alpha1.PreCachingConfig)
+	src.ObjectMeta.DeepCopyInto(&dst.ObjectMeta)
+	dst.Spec = v1alpha1.PreCachingConfigSpec{}
+	if err := convertThroughJSON(&src.Spec, &dst.Spec); err != nil {
+		return err
+	}
+
+	removedFields := preCachingConfigRemovedFields{}
+	if err := restoreRemovedFields(&dst.ObjectMeta, &removedFields); err != nil {
+		return err
+	}
+	// nolint: staticcheck
+	dst.Spec.Overrides.PlatformImage = removedFields.PlatformImage
+	dst.Spec.Overrides.OperatorsIndexes = removedFields.OperatorsIndexes // nolint: staticcheck
+	dst.Spec.Overrides.PreCacheImage = removedFields.PreCacheImage       // nolint: staticcheck
+	return nil
+}
+
+// ConvertFrom converts the hub version (v1alpha1) to this PreCachingConfig
+func (dst *PreCachingConfig) ConvertFrom(srcRaw conversion.Hub) error {
+	src := srcRaw.(*v1alpha1.PreCachingConfig)
+	src.ObjectMeta.DeepCopyInto(&dst.ObjectMeta)
+	dst.Spec = PreCachingConfigSpec{}
+	if err := convertThroughJSON(&src.Spec, &dst.Spec); err != nil {
+		return err
+	}
+
+	// nolint: staticcheck
+	removedFields := preCachingConfigRemovedFields{
+		PlatformImage:    src.Spec.Overrides.PlatformImage,
+		OperatorsIndexes: src.Spec.Overrides.OperatorsIndexes,
+		PreCacheImage:    src.Spec.Overrides.PreCacheImage,
+	}
+	return saveRemovedFields(&dst.ObjectMeta, removedFields)
+}
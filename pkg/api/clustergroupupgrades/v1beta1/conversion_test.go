@@ -0,0 +1,116 @@
+package v1beta1
+
+import (
+	"testing"
+
+	"github.com/openshift-kni/cluster-group-upgrades-operator/pkg/api/clustergroupupgrades/v1alpha1"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	mwv1 "open-cluster-management.io/api/work/v1"
+)
+
+func TestClusterGroupUpgradeConversion(t *testing.T) {
+	enable := true
+	hub := &v1alpha1.ClusterGroupUpgrade{
+		ObjectMeta: metav1.ObjectMeta{Name: "cgu", Namespace: "default", Annotations: map[string]string{"a": "b"}},
+		Spec: v1alpha1.ClusterGroupUpgradeSpec{
+			Backup:          true,
+			Enable:          &enable,
+			Clusters:        []string{"spoke1"},
+			ClusterSelector: []string{"upgrade=true"},
+			ManagedPolicies: []string{"policy1"},
+			RemediationStrategy: &v1alpha1.RemediationStrategySpec{
+				MaxConcurrency:           2,
+				MaxConcurrencyPercentage: 10,
+				Timeout:                  240,
+			},
+			Actions: v1alpha1.Actions{
+				BeforeEnable: &v1alpha1.BeforeEnable{DeleteClusterLabels: map[string]string{"ztp-done": ""}},
+			},
+		},
+		Status: v1alpha1.ClusterGroupUpgradeStatus{
+			CopiedPolicies: []string{"policy1-copy"},
+			Conditions: []metav1.Condition{
+				{Type: "BackupSuceeded", Status: metav1.ConditionTrue, Reason: "Completed"},
+				{Type: "Validated", Status: metav1.ConditionFalse, Reason: "UnresolvableDenpendency"},
+			},
+			Clusters: []v1alpha1.ClusterState{
+				{Name: "spoke1", State: "complete"},
+				{Name: "spoke2", State: "timedout", Reason: "batch timed out",
+					CurrentPolicy: &v1alpha1.PolicyStatus{Name: "policy1", Status: "NonCompliant"}},
+				{Name: "spoke3", State: "timedout",
+					CurrentManifestWork: &v1alpha1.ManifestWorkStatus{Name: "mw1", Status: mwv1.ManifestResourceStatus{
+						Manifests: []mwv1.ManifestCondition{{ResourceMeta: mwv1.ManifestResourceMeta{Name: "ns1"}}}}}},
+			},
+		},
+	}
+
+	spoke := &ClusterGroupUpgrade{}
+	assert.NoError(t, spoke.ConvertFrom(hub.DeepCopy()))
+	assert.Equal(t, []string{"spoke1"}, spoke.Spec.Clusters)
+	assert.Equal(t, intstr.FromString("10%"), spoke.Spec.RemediationStrategy.MaxConcurrency)
+	assert.Equal(t, "BackupSucceeded", spoke.Status.Conditions[0].Type)
+	assert.Equal(t, "UnresolvableDependency", spoke.Status.Conditions[1].Reason)
+	assert.Equal(t, ClusterFinalStates.Complete, spoke.Status.Clusters[0].State)
+	assert.Nil(t, spoke.Status.Clusters[0].LastStep)
+	assert.Equal(t, &ClusterRemediationStep{Type: RolloutTypes.Policy, Name: "policy1", PolicyStatus: "NonCompliant"},
+		spoke.Status.Clusters[1].LastStep)
+	assert.Equal(t, RolloutTypes.ManifestWork, spoke.Status.Clusters[2].LastStep.Type)
+	assert.Equal(t, "ns1", spoke.Status.Clusters[2].LastStep.ManifestWorkStatus.Manifests[0].ResourceMeta.Name)
+	assert.Equal(t, "b", spoke.Annotations["a"])
+	assert.JSONEq(t,
+		`{"backup":true,"clusterSelector":["upgrade=true"],"beforeEnableDeleteClusterLabels":{"ztp-done":""},"maxConcurrency":2}`,
+		spoke.Annotations[RemovedFieldsAnnotation])
+
+	converted := &v1alpha1.ClusterGroupUpgrade{}
+	assert.NoError(t, spoke.ConvertTo(converted))
+	assert.Equal(t, hub.ObjectMeta, converted.ObjectMeta)
+	assert.Equal(t, hub.Spec, converted.Spec)
+	assert.Equal(t, hub.Status.Conditions, converted.Status.Conditions)
+	assert.Equal(t, hub.Status.Clusters, converted.Status.Clusters)
+	// The deprecated status fields are dropped
+	assert.Empty(t, converted.Status.CopiedPolicies)
+
+	// A percentage set through v1beta1
+	spoke.Annotations = nil
+	spoke.Spec.RemediationStrategy.MaxConcurrency = intstr.FromString("25%")
+	converted = &v1alpha1.ClusterGroupUpgrade{}
+	assert.NoError(t, spoke.ConvertTo(converted))
+	assert.Equal(t, 25, converted.Spec.RemediationStrategy.MaxConcurrencyPercentage)
+	assert.Equal(t, 0, converted.Spec.RemediationStrategy.MaxConcurrency)
+	spoke.Spec.RemediationStrategy.MaxConcurrency = intstr.FromInt32(5)
+	converted = &v1alpha1.ClusterGroupUpgrade{}
+	assert.NoError(t, spoke.ConvertTo(converted))
+	assert.Equal(t, 5, converted.Spec.RemediationStrategy.MaxConcurrency)
+	assert.Zero(t, converted.Spec.RemediationStrategy.MaxConcurrencyPercentage)
+
+	// Nothing to keep
+	hub = &v1alpha1.ClusterGroupUpgrade{ObjectMeta: metav1.ObjectMeta{Name: "cgu", Namespace: "default"}}
+	spoke = &ClusterGroupUpgrade{}
+	assert.NoError(t, spoke.ConvertFrom(hub))
+	assert.Nil(t, spoke.Annotations)
+}
+
+func TestPreCachingConfigConversion(t *testing.T) {
+	hub := &v1alpha1.PreCachingConfig{
+		ObjectMeta: metav1.ObjectMeta{Name: "pcc", Namespace: "default"},
+		Spec: v1alpha1.PreCachingConfigSpec{
+			Overrides: v1alpha1.PlatformPreCachingSpec{
+				PlatformImage:                "quay.io/openshift-release-dev/ocp-release:4.16.0-x86_64",
+				OperatorsPackagesAndChannels: []string{"local-storage-operator:stable"},
+			},
+			SpaceRequired: "45 GiB",
+		},
+	}
+
+	spoke := &PreCachingConfig{}
+	assert.NoError(t, spoke.ConvertFrom(hub.DeepCopy()))
+	assert.Equal(t, []string{"local-storage-operator:stable"}, spoke.Spec.Overrides.OperatorsPackagesAndChannels)
+	assert.Equal(t, "45 GiB", spoke.Spec.SpaceRequired)
+	assert.Contains(t, spoke.Annotations, RemovedFieldsAnnotation)
+
+	converted := &v1alpha1.PreCachingConfig{}
+	assert.NoError(t, spoke.ConvertTo(converted))
+	assert.Equal(t, hub, converted)
+}
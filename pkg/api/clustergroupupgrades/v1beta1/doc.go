@@ -0,0 +1,4 @@
+// +k8s:deepcopy-gen=package
+// +groupName=ran.openshift.io
+
+package v1beta1
@@ -0,0 +1,711 @@
+/*
+Copyright 2021.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	mwv1 "open-cluster-management.io/api/work/v1"
+)
+
+// EDIT THIS FILE!  THIS IS SCAFFOLDING FOR YOU TO OWN!
+// NOTE: json tags are required.  Any new fields you add must have json tags for the fields to be serialized.
+
+// SpreadBySpec limits how many clusters of the same topology domain can be remediated in the same batch
+type SpreadBySpec struct {
+	// LabelKey is the ManagedCluster label identifying the topology domain of a cluster, e.g. its site or region
+	LabelKey string `json:"labelKey"`
+	// MaxPerBatch is the maximum number of clusters with the same LabelKey value in a single batch
+	//+kubebuilder:default=1
+	//+kubebuilder:validation:Minimum=1
+	MaxPerBatch int `json:"maxPerBatch,omitempty"`
+}
+
+// UnavailableClustersSpec defines how the clusters that are unavailable when their batch starts are handled
+type UnavailableClustersSpec struct {
+	// Action is Skip, to remove the unavailable clusters from the CGU, or Defer, to move them to the end of the
+	// remediation plan. Deferred clusters that are still unavailable when the last batch starts are skipped.
+	//+kubebuilder:validation:Enum=Skip;Defer
+	//+kubebuilder:default=Skip
+	Action string `json:"action,omitempty"`
+	// MaxLeaseAge also considers a cluster unavailable when its lease has not been renewed for longer than that,
+	// e.g. "5m", even if its ManagedCluster is still reported as available.
+	MaxLeaseAge *metav1.Duration `json:"maxLeaseAge,omitempty"`
+}
+
+// UnavailableClustersAction selections
+var UnavailableClustersAction = struct {
+	Skip  string
+	Defer string
+}{
+	Skip:  "Skip",
+	Defer: "Defer",
+}
+
+// MaintenanceWindow defines a recurring period of time during which remediation can start
+type MaintenanceWindow struct {
+	// DaysOfWeek are the days the window opens on. The window opens every day if empty.
+	//+kubebuilder:validation:items:Enum=Mon;Tue;Wed;Thu;Fri;Sat;Sun
+	DaysOfWeek []string `json:"daysOfWeek,omitempty"`
+	// StartTime is the time of the day the window opens, in the HH:MM 24-hour format
+	//+kubebuilder:validation:Pattern=`^([01][0-9]|2[0-3]):[0-5][0-9]$`
+	StartTime string `json:"startTime"`
+	// Duration is how long the window stays open, e.g. "4h" or "90m"
+	Duration metav1.Duration `json:"duration"`
+	// TimeZone is the IANA time zone name of StartTime, e.g. "Europe/Madrid". Defaults to UTC.
+	TimeZone string `json:"timeZone,omitempty"`
+}
+
+// ExpectedCondition defines the status a condition of a resource must have
+type ExpectedCondition struct {
+	Type string `json:"type"`
+	// Status is True, False or Unknown. A missing condition is considered False.
+	//+kubebuilder:validation:Enum=True;False;Unknown
+	//+kubebuilder:default=True
+	Status metav1.ConditionStatus `json:"status,omitempty"`
+}
+
+// ResourceConditionCheck defines a resource of a managed cluster whose conditions must have the expected statuses
+type ResourceConditionCheck struct {
+	// Resource is the kind or plural name of the resource, qualified by its API group if it is not a core resource,
+	// e.g. "Deployment.apps" or "ClusterOperator.config.openshift.io"
+	Resource  string `json:"resource"`
+	Name      string `json:"name"`
+	Namespace string `json:"namespace,omitempty"`
+	//+kubebuilder:validation:MinItems=1
+	Conditions []ExpectedCondition `json:"conditions"`
+}
+
+// HealthChecksSpec defines the health checks run on a managed cluster through ManagedClusterViews
+type HealthChecksSpec struct {
+	// ClusterVersion checks that the ClusterVersion is not progressing, i.e. no platform upgrade is in progress.
+	ClusterVersion bool `json:"clusterVersion,omitempty"`
+	// ClusterVersionAvailable checks that the ClusterVersion is available and not failing. The ClusterOperators
+	// are not checked individually, only through what the ClusterVersion reports: list them in resources to check
+	// that each of them is available and not degraded.
+	ClusterVersionAvailable bool `json:"clusterVersionAvailable,omitempty"`
+	// MachineConfigPools checks that no MachineConfigPool of machineConfigPoolNames is updating or degraded.
+	MachineConfigPools bool `json:"machineConfigPools,omitempty"`
+	// Nodes checks that all the machines of the MachineConfigPools of machineConfigPoolNames are ready.
+	Nodes bool `json:"nodes,omitempty"`
+	// Etcd checks that the etcd ClusterOperator is available and not degraded.
+	Etcd bool `json:"etcd,omitempty"`
+	// MachineConfigPoolNames are the MachineConfigPools used by the machineConfigPools and nodes checks.
+	// Defaults to master and worker.
+	MachineConfigPoolNames []string `json:"machineConfigPoolNames,omitempty"`
+	// Resources are additional resources whose conditions must have the expected statuses.
+	Resources []ResourceConditionCheck `json:"resources,omitempty"`
+}
+
+// RemediationStrategySpec defines the remediation policy
+type RemediationStrategySpec struct {
+	// Canaries defines the list of managed clusters that should be remediated first when remediateAction is set to enforce
+	Canaries []string `json:"canaries,omitempty"`
+	// OnCanaryFailure is what happens when the canary batches time out. Stop, the default, ends the CGU. Rollback
+	// also enforces the rollbackPolicies on the canary clusters before ending it. Rollback is only supported when
+	// remediating managedPolicies.
+	//+kubebuilder:validation:Enum=Stop;Rollback
+	//+operator-sdk:csv:customresourcedefinitions:type=spec,displayName="On Canary Failure",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
+	OnCanaryFailure string `json:"onCanaryFailure,omitempty"`
+	// RollbackPolicies are the inform policies enforced on the canary clusters when onCanaryFailure is Rollback,
+	// e.g. policies setting the operator subscriptions back to their previous channels. Like managedPolicies,
+	// they must be bound to the canary clusters.
+	//+operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Rollback Policies",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
+	RollbackPolicies []string `json:"rollbackPolicies,omitempty"`
+	// Mode is how the clusters following the canaries are remediated. In Batch mode (the default), they are
+	// split into batches of maxConcurrency clusters and a batch only starts once the previous one is done.
+	// In Rolling mode, up to maxConcurrency clusters are remediated at any time and the next cluster starts
+	// as soon as one is done. Rolling cannot be used together with batchSizes, spreadBy and batchBy.
+	//+kubebuilder:validation:Enum=Batch;Rolling
+	//+operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Mode",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
+	Mode string `json:"mode,omitempty"`
+	// MaxConcurrency is the maximum number of clusters remediated at the same time, i.e. the batch size.
+	// It can be a number (e.g. 10) or a percentage of the selected clusters (e.g. "10%", rounded up).
+	//kubebuilder:validation:Minimum=1
+	//+kubebuilder:validation:XIntOrString
+	MaxConcurrency intstr.IntOrString `json:"maxConcurrency"`
+	// BatchSizes enables a progressive rollout where batches start small and grow as confidence grows,
+	// e.g. [1, 5, "25%", "50%"]. Each entry is the size of one batch, either a number of clusters or a
+	// percentage of the selected clusters (rounded up). The batches follow the canaries, and any clusters
+	// left once the list is exhausted are remediated in batches of maxConcurrency.
+	//+operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Batch Sizes",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
+	BatchSizes []intstr.IntOrString `json:"batchSizes,omitempty"`
+	// SpreadBy guarantees that no batch contains more than maxPerBatch clusters with the same value of the
+	// given ManagedCluster label. Clusters without the label are not restricted. Batches that cannot be
+	// filled without breaking that rule are started with fewer clusters. Cannot be used together with BatchBy.
+	//+operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Spread By",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
+	SpreadBy *SpreadBySpec `json:"spreadBy,omitempty"`
+	// BatchBy is a ManagedCluster label key used to remediate whole topology domains together: all the
+	// clusters with the same label value are put in the same batch, and several domains share a batch
+	// as long as it does not grow over its size. A domain bigger than the batch size gets a batch of its own.
+	// Clusters without the label are batched after the labeled ones. Cannot be used together with SpreadBy.
+	//+operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Batch By",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
+	BatchBy string `json:"batchBy,omitempty"`
+	// UnavailableClusters checks that the clusters of a batch are available when the batch starts, and skips or
+	// defers the unavailable ones instead of letting them time out. Unset means the clusters are not checked.
+	//+operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Unavailable Clusters",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
+	UnavailableClusters *UnavailableClustersSpec `json:"unavailableClusters,omitempty"`
+	//+kubebuilder:default=240
+	Timeout int `json:"timeout,omitempty"`
+	// ClusterTimeout is the number of minutes a cluster is given to complete its remediation once started. A cluster
+	// that has not completed in time is marked timed out and removed from its batch, so that the rest of the batch
+	// does not wait for it. Unset means the clusters are only bound by the batch timeout.
+	//+kubebuilder:validation:Minimum=0
+	//+operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Cluster Timeout",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
+	ClusterTimeout int `json:"clusterTimeout,omitempty"`
+	// MaxFailures is the number (e.g. 3) or percentage (e.g. "10%") of the clusters in the remediation plan
+	// that are allowed to time out across all batches. Once more clusters than that have timed out, the CGU
+	// is stopped instead of moving on to the next batch. Percentages are rounded down. Unset means no limit.
+	//+kubebuilder:validation:XIntOrString
+	//+operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Max Failures",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
+	MaxFailures *intstr.IntOrString `json:"maxFailures,omitempty"`
+}
+
+// PolicyOverride defines the settings of a managed policy for a CGU
+type PolicyOverride struct {
+	// Name is the name of the managed policy
+	//+kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+	// SoakSeconds is the least number of seconds a cluster must stay compliant with the policy before moving on
+	// to the next one. It overrides the ran.openshift.io/soak-seconds annotation of the policy.
+	//+kubebuilder:validation:Minimum=0
+	SoakSeconds *int `json:"soakSeconds,omitempty"`
+	// Timeout is the number of minutes a cluster is given to become compliant with the policy, soak time included.
+	// A cluster that has not moved on to the next policy in time is marked timed out and removed from its batch.
+	//+kubebuilder:validation:Minimum=1
+	Timeout *int `json:"timeout,omitempty"`
+	// SkipIfCompliant controls whether the policy is left out of the remediation when all the clusters are
+	// already compliant with it. By default, it is left out unless its objects check status fields, since their
+	// compliance may change as the previous policies are enforced.
+	SkipIfCompliant *bool `json:"skipIfCompliant,omitempty"`
+}
+
+// RemediationMode selections
+var RemediationMode = struct {
+	Batch   string
+	Rolling string
+}{
+	Batch:   "Batch",
+	Rolling: "Rolling",
+}
+
+// OnCanaryFailureAction selections
+var OnCanaryFailureAction = struct {
+	Stop     string
+	Rollback string
+}{
+	Stop:     "Stop",
+	Rollback: "Rollback",
+}
+
+// NamespacedCR defines the name and namespace of a custom resource
+type NamespacedCR struct {
+	Name      string `json:"name,omitempty"`
+	Namespace string `json:"namespace,omitempty"`
+}
+
+// BlockingCR defines the Upgrade CRs that block the current CR from running if not completed
+type BlockingCR NamespacedCR
+
+// PreCachingConfigCR defines the reference to the pre-caching config CR
+type PreCachingConfigCR NamespacedCR
+
+// Actions defines the actions to be done either before or after the managedPolicies are remediated
+type Actions struct {
+	BeforeEnable    *BeforeEnable    `json:"beforeEnable,omitempty"`
+	AfterCompletion *AfterCompletion `json:"afterCompletion,omitempty"`
+}
+
+// BeforeEnable defines the actions to be done before starting upgrade
+type BeforeEnable struct {
+	// This field defines a map of key/value pairs that identify the cluster labels
+	// to be added or updated to the defined clusters.
+	AddClusterLabels map[string]string `json:"addClusterLabels,omitempty"`
+	// This field defines a list of labels to be removed for the defined clusters.
+	RemoveClusterLabels []string `json:"removeClusterLabels,omitempty"`
+	// This field defines a map of key/value pairs that identify the cluster annotations
+	// to be added or updated to the defined clusters.
+	AddClusterAnnotations map[string]string `json:"addClusterAnnotations,omitempty"`
+	// This field defines a list of annotations to be removed for the defined clusters.
+	RemoveClusterAnnotations []string `json:"removeClusterAnnotations,omitempty"`
+}
+
+// AfterCompletion defines the actions to be done after upgrade is completed
+type AfterCompletion struct {
+	// This field defines a map of key/value pairs that identify the cluster labels
+	// to be added to the defined clusters.
+	AddClusterLabels map[string]string `json:"addClusterLabels,omitempty"`
+	// This field defines a list of labels to be removed for the defined clusters.
+	RemoveClusterLabels []string `json:"removeClusterLabels,omitempty"`
+	// This field defines a map of key/value pairs that identify the cluster annotations
+	// to be added or updated to the defined clusters.
+	AddClusterAnnotations map[string]string `json:"addClusterAnnotations,omitempty"`
+	// This field defines a list of annotations to be removed for the defined clusters.
+	RemoveClusterAnnotations []string `json:"removeClusterAnnotations,omitempty"`
+	// This field defines whether clean up the resources created for upgrade
+	//+kubebuilder:default=true
+	DeleteObjects *bool `json:"deleteObjects,omitempty"`
+}
+
+// BatchTimeoutAction selections
+var BatchTimeoutAction = struct {
+	Continue string
+	Abort    string
+}{
+	Continue: "Continue",
+	Abort:    "Abort",
+}
+
+// ConflictAction selections
+var ConflictAction = struct {
+	Wait                       string
+	WaitForOverlappingClusters string
+}{
+	Wait:                       "Wait",
+	WaitForOverlappingClusters: "WaitForOverlappingClusters",
+}
+
+// OperatorUpgradeSpec defines the configuration of an operator upgrade
+type OperatorUpgradeSpec struct {
+	Channel   string `json:"channel,omitempty"`
+	Name      string `json:"name,omitempty"`
+	Namespace string `json:"namespace,omitempty"`
+}
+
+// ClusterGroupUpgradeSpec defines the desired state of ClusterGroupUpgrade
+type ClusterGroupUpgradeSpec struct {
+	// INSERT ADDITIONAL SPEC FIELDS - desired state of cluster
+	// Important: Run "make" to regenerate code after modifying this file
+
+	// This field determines whether container image pre-caching will be done on all the clusters
+	// matching the selector.
+	// If required, the pre-caching process starts immediately on all clusters irrespectively of
+	// the value of the "enable" flag
+	//+kubebuilder:default=false
+	//+operator-sdk:csv:customresourcedefinitions:type=spec,displayName="PreCaching",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:bool"}
+	PreCaching bool `json:"preCaching,omitempty"`
+	// This field specifies a reference to a pre-caching config custom resource that contains the additional
+	// pre-caching configurations.
+	//+operator-sdk:csv:customresourcedefinitions:type=spec,displayName="PreCachingConfigRef",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
+	PreCachingConfigRef PreCachingConfigCR `json:"preCachingConfigRef,omitempty"`
+	// MaintenanceWindows restricts when new batches can start. Outside of the windows, the CGU waits
+	// for the next window to open and the time spent waiting is not counted against the timeouts.
+	// Batches already started keep running when a window closes. Clusters can also have their own
+	// windows in the ran.openshift.io/maintenance-windows ManagedCluster annotation, in which case
+	// their remediation only starts inside them.
+	//+operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Maintenance Windows",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
+	MaintenanceWindows []MaintenanceWindow `json:"maintenanceWindows,omitempty"`
+	// PreflightChecks are health checks run on each cluster right before its remediation starts. The remediation
+	// waits for the results of the checks, and the clusters failing any of them are skipped and reported with the
+	// preflightfailed state and the reason of the failure.
+	//+operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Preflight Checks",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
+	PreflightChecks *HealthChecksSpec `json:"preflightChecks,omitempty"`
+	// Verification are health checks run on each cluster once it is compliant with all the managed policies or
+	// all its manifestWorkTemplates are applied. The cluster is only marked as completed, and the afterCompletion
+	// actions taken, once the checks have passed. A cluster that does not pass them stays in progress and eventually
+	// times out.
+	//+operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Verification",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
+	Verification *HealthChecksSpec `json:"verification,omitempty"`
+	// This field determines when the CGU starts. While false, the CGU doesn't start.
+	// Once set to true, policy rollout starts on the clusters, one batch at a time.
+	// Setting it back to false while the CGU is in progress pauses the rollout: no new
+	// batches or clusters are remediated and the timeout clock is stopped until it is
+	// set to true again.
+	//+kubebuilder:default=true
+	//+operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Enable",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:bool"}
+	Enable *bool `json:"enable,omitempty"`
+	// StartAt schedules the start of the CGU at the given time (RFC3339). Until then, the CGU waits as if
+	// it was not enabled, but pre-caching is still done ahead of time. It has no effect while enable is false.
+	//+kubebuilder:validation:Format=date-time
+	//+operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Start At",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
+	StartAt *metav1.Time `json:"startAt,omitempty"`
+	// DryRun computes the plan of the CGU and reports it in status.dryRun without remediating any cluster:
+	// no Placements, PlacementBindings, ManifestWorks, ManagedClusterActions or pre-caching jobs are created.
+	// Setting it back to false lets the CGU start as usual.
+	//+operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Dry Run",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:bool"}
+	DryRun bool `json:"dryRun,omitempty"`
+	// DynamicMembership keeps the cluster selection up to date while the CGU is in progress. Newly selected clusters
+	// that need remediation are appended to the remaining batches, and clusters whose ManagedCluster is deleted are
+	// removed from the remaining batches and reported with the removed state instead of timing out.
+	//+operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Dynamic Membership",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:bool"}
+	DynamicMembership bool `json:"dynamicMembership,omitempty"`
+	// RetryOf references a completed ClusterGroupUpgrade whose timed out clusters are remediated again by this one.
+	// It cannot be used together with clusters and clusterLabelSelectors. When managedPolicies
+	// and manifestWorkTemplates are both empty, the ones of the referenced ClusterGroupUpgrade are used and
+	// recorded in status.inheritedManagedPolicies and status.inheritedManifestWorkTemplates.
+	// The namespace defaults to the namespace of this ClusterGroupUpgrade.
+	//+operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Retry Of",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
+	RetryOf *NamespacedCR `json:"retryOf,omitempty"`
+	//+operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Clusters",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
+	Clusters []string `json:"clusters,omitempty"`
+	// This field holds a list of expressions or labels that will be used to determine what clusters to include in the operation.
+	// The expected format is as follows:
+	// clusterLabelSelectors:
+	//   - matchExpressions:
+	//       - key: label1
+	//         operator: In
+	//         values:
+	//           - value1a
+	//           - value1b
+	//   - matchLabels:
+	//       label2: value2
+	//   - matchExpressions:
+	//       - key: label3
+	//         operator: In
+	//         values:
+	//           - value3
+	//     matchLabels:
+	//       label4: value4
+	//+operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Cluster Label Selectors",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
+	ClusterLabelSelectors []metav1.LabelSelector `json:"clusterLabelSelectors,omitempty"`
+	//+operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Remediation Strategy",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
+	RemediationStrategy *RemediationStrategySpec `json:"remediationStrategy"`
+	//+kubebuilder:validation:Optional
+	//+operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Managed Policies",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
+	ManagedPolicies []string `json:"managedPolicies,omitempty"`
+	// PolicyOverrides are settings of managedPolicies that only apply to this CGU and take precedence over the
+	// ones declared on the policies, e.g. the ran.openshift.io/soak-seconds annotation.
+	//+operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Policy Overrides",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
+	PolicyOverrides []PolicyOverride `json:"policyOverrides,omitempty"`
+	//+operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Manifest Work Templates",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
+	//+kubebuilder:validation:Optional
+	ManifestWorkTemplates []string `json:"manifestWorkTemplates"`
+	//+operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Blocking CRs",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
+	BlockingCRs []BlockingCR `json:"blockingCRs,omitempty"`
+	// Priority orders the active ClusterGroupUpgrades remediating some of the same clusters: the one with the higher
+	// priority goes first, and the ones with the same priority go in creation order. A ClusterGroupUpgrade in progress
+	// is never preempted. The default value is 0.
+	//+operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Priority",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:number"}
+	Priority int `json:"priority,omitempty"`
+	// The Conflict Action controls what waits for the conflicting ClusterGroupUpgrades, i.e. the active ones remediating
+	// some of the same clusters that are in progress or go first. The default value is `Wait`.
+	// The possible values are:
+	//   - Wait: the ClusterGroupUpgrade doesn't start until the conflicting ones are done with the overlapping clusters
+	//   - WaitForOverlappingClusters: the ClusterGroupUpgrade starts and only the overlapping clusters wait
+	//+kubebuilder:validation:Enum=Wait;WaitForOverlappingClusters
+	//+operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Conflict Action",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
+	ConflictAction string `json:"conflictAction,omitempty"`
+	//+operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Actions",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
+	Actions Actions `json:"actions,omitempty"`
+	// The Batch Timeout Action can be specified to control what happens when a batch times out. The default value is `Continue`.
+	// The possible values are:
+	//   - Continue
+	//   - Abort
+	//+operator-sdk:csv:customresourcedefinitions:type=spec,displayName="BatchTimeoutAction",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
+	BatchTimeoutAction string `json:"batchTimeoutAction,omitempty"`
+}
+
+// RolloutType is a string representing the rollout type
+type RolloutType string
+
+// RolloutTypes define the supported rollout types
+var RolloutTypes = struct {
+	ManifestWork RolloutType
+	Policy       RolloutType
+}{
+	ManifestWork: "ManifestWork",
+	Policy:       "Policy",
+}
+
+// RolloutType returns the rollout type based on the spec content
+func (cgu ClusterGroupUpgrade) RolloutType() RolloutType {
+	if len(cgu.Spec.ManifestWorkTemplates) > 0 {
+		return RolloutTypes.ManifestWork
+	}
+	return RolloutTypes.Policy
+}
+
+// ClusterRemediationProgress stores the remediation progress of a cluster
+type ClusterRemediationProgress struct {
+	// State should be one of the following: NotStarted, InProgress, Completed
+	State             string      `json:"state,omitempty"`
+	ManifestWorkIndex *int        `json:"manifestWorkIndex,omitempty"`
+	PolicyIndex       *int        `json:"policyIndex,omitempty"`
+	FirstCompliantAt  metav1.Time `json:"firstCompliantAt,omitempty"`
+	// StartedAt is set when the remediation of the cluster starts.
+	StartedAt *metav1.Time `json:"startedAt,omitempty"`
+	// PolicyStartedAt is set when the cluster moves on to the policy at PolicyIndex.
+	PolicyStartedAt *metav1.Time `json:"policyStartedAt,omitempty"`
+}
+
+// ClusterRemediationProgress possible states
+const (
+	NotStarted = "NotStarted"
+	InProgress = "InProgress"
+	Completed  = "Completed"
+)
+
+// UpgradeStatus defines the observed state of the upgrade
+type UpgradeStatus struct {
+	StartedAt             metav1.Time `json:"startedAt,omitempty"`
+	CompletedAt           metav1.Time `json:"completedAt,omitempty"`
+	CurrentBatch          int         `json:"currentBatch,omitempty"`
+	CurrentBatchStartedAt metav1.Time `json:"currentBatchStartedAt,omitempty"`
+	// PausedAt is set when an in-progress CGU is paused by setting spec.enable to false.
+	PausedAt *metav1.Time `json:"pausedAt,omitempty"`
+	// WaitingForMaintenanceWindowSince is set while the next batch waits for a maintenance window to open.
+	WaitingForMaintenanceWindowSince *metav1.Time `json:"waitingForMaintenanceWindowSince,omitempty"`
+
+	CurrentBatchRemediationProgress map[string]*ClusterRemediationProgress `json:"currentBatchRemediationProgress,omitempty"`
+}
+
+// ManagedPolicyForUpgrade defines the observed state of a Policy
+type ManagedPolicyForUpgrade struct {
+	Name      string `json:"name,omitempty"`
+	Namespace string `json:"namespace,omitempty"`
+}
+
+// PolicyStatus defines the status of a certain policy
+type PolicyStatus struct {
+	Name   string `json:"name"`
+	Status string `json:"status,omitempty"`
+}
+
+// ManifestWorkStatus defines the status of a certain ManifestWork
+type ManifestWorkStatus struct {
+	Name   string                      `json:"name"`
+	Status mwv1.ManifestResourceStatus `json:"status,omitempty"`
+}
+
+// ClusterFinalState is the state a cluster is left in once the ClusterGroupUpgrade is done with it
+type ClusterFinalState string
+
+// ClusterFinalStates define the supported cluster final states
+var ClusterFinalStates = struct {
+	Complete        ClusterFinalState
+	TimedOut        ClusterFinalState
+	Removed         ClusterFinalState
+	Unavailable     ClusterFinalState
+	PreflightFailed ClusterFinalState
+}{
+	Complete:        "complete",
+	TimedOut:        "timedout",
+	Removed:         "removed",
+	Unavailable:     "unavailable",
+	PreflightFailed: "preflightfailed",
+}
+
+// ClusterRemediationStep defines the policy or ManifestWork a cluster was remediating
+type ClusterRemediationStep struct {
+	// Type is Policy or ManifestWork
+	//+kubebuilder:validation:Enum=Policy;ManifestWork
+	Type RolloutType `json:"type"`
+	Name string      `json:"name"`
+	// PolicyStatus is the compliance status of the cluster with the policy
+	PolicyStatus string `json:"policyStatus,omitempty"`
+	// ManifestWorkStatus is the status of the resources of the ManifestWork
+	ManifestWorkStatus *mwv1.ManifestResourceStatus `json:"manifestWorkStatus,omitempty"`
+}
+
+// ClusterState defines the final state of a cluster
+type ClusterState struct {
+	Name string `json:"name"`
+	//+kubebuilder:validation:Enum=complete;timedout;removed;unavailable;preflightfailed
+	State ClusterFinalState `json:"state"`
+	// Reason explains the state, e.g. why the cluster timed out or failed its preflight checks
+	Reason string `json:"reason,omitempty"`
+	// LastStep is the policy or ManifestWork the cluster was at when it reached its final state, if it did not complete
+	LastStep *ClusterRemediationStep `json:"lastStep,omitempty"`
+}
+
+// PrecachingSpec defines the pre-caching software spec derived from policies
+type PrecachingSpec struct {
+	PlatformImage                string   `json:"platformImage,omitempty"`
+	OperatorsIndexes             []string `json:"operatorsIndexes,omitempty"`
+	OperatorsPackagesAndChannels []string `json:"operatorsPackagesAndChannels,omitempty"`
+	ExcludePrecachePatterns      []string `json:"excludePrecachePatterns,omitempty"`
+	SpaceRequired                string   `json:"spaceRequired,omitempty"`
+	AdditionalImages             []string `json:"additionalImages,omitempty"`
+}
+
+// PrecachingStatus defines the observed pre-caching status
+type PrecachingStatus struct {
+	Spec   *PrecachingSpec   `json:"spec,omitempty"`
+	Status map[string]string `json:"status,omitempty"`
+}
+
+// DryRunStatus holds the plan computed for a CGU in dry run mode
+type DryRunStatus struct {
+	ComputedAt metav1.Time `json:"computedAt,omitempty"`
+	// All the clusters selected by the CGU
+	SelectedClusters []string `json:"selectedClusters,omitempty"`
+	// The selected clusters that are already compliant and would not be remediated
+	CompliantClusters []string `json:"compliantClusters,omitempty"`
+	// The batches in which the clusters would be remediated
+	Batches [][]string `json:"batches,omitempty"`
+	// The remediation plan of each cluster to remediate
+	Clusters []DryRunClusterPlan `json:"clusters,omitempty"`
+}
+
+// DryRunClusterPlan holds what would be remediated on a cluster
+type DryRunClusterPlan struct {
+	Name string `json:"name"`
+	// Index of the batch the cluster is in
+	BatchIndex int `json:"batchIndex"`
+	// The managed policies the cluster is NonCompliant with, in the order they would be enforced
+	NonCompliantPolicies []string `json:"nonCompliantPolicies,omitempty"`
+	// The subscriptions (namespace/name) in those policies whose InstallPlans would be approved
+	SubscriptionsToApprove []string `json:"subscriptionsToApprove,omitempty"`
+}
+
+// RollbackStatus defines the observed state of the rollback of the canary clusters
+type RollbackStatus struct {
+	StartedAt   metav1.Time  `json:"startedAt,omitempty"`
+	CompletedAt *metav1.Time `json:"completedAt,omitempty"`
+	// The canary clusters being rolled back
+	Clusters []string `json:"clusters,omitempty"`
+	// The rollback policies enforced on the canary clusters
+	Policies []ManagedPolicyForUpgrade `json:"policies,omitempty"`
+}
+
+// RollingStatus defines the observed progress of a Rolling remediation
+type RollingStatus struct {
+	// The clusters being remediated
+	InFlightClusters []string `json:"inFlightClusters,omitempty"`
+	// The number of clusters waiting for a free slot
+	QueuedClusters int `json:"queuedClusters"`
+	// The number of clusters that completed their remediation
+	CompletedClusters int `json:"completedClusters"`
+	// The number of clusters that timed out or failed their preflight checks
+	FailedClusters int `json:"failedClusters"`
+}
+
+// BackupStatus defines the observed backup status of the ClusterGroupUpgrades created with v1alpha1
+type BackupStatus struct {
+	StartedAt metav1.Time       `json:"startedAt,omitempty"`
+	Status    map[string]string `json:"status,omitempty"`
+}
+
+// ClusterGroupUpgradeStatus defines the observed state of ClusterGroupUpgrade
+type ClusterGroupUpgradeStatus struct {
+	// INSERT ADDITIONAL STATUS FIELD - define observed state of cluster
+	// Important: Run "make" to regenerate code after modifying this file
+	// +operator-sdk:csv:customresourcedefinitions:type=status,displayName="Placement Bindings"
+	PlacementBindings []string `json:"placementBindings,omitempty"`
+	// +operator-sdk:csv:customresourcedefinitions:type=status,displayName="Placements"
+	Placements []string `json:"placements,omitempty"`
+	// +operator-sdk:csv:customresourcedefinitions:type=status,displayName="Conditions"
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// +operator-sdk:csv:customresourcedefinitions:type=status,displayName="Remediation Plan"
+	RemediationPlan [][]string `json:"remediationPlan,omitempty"`
+	// +operator-sdk:csv:customresourcedefinitions:type=status,displayName="Managed Policies Namespace"
+	ManagedPoliciesNs map[string]string `json:"managedPoliciesNs,omitempty"`
+	// +operator-sdk:csv:customresourcedefinitions:type=status,displayName="Safe Resource Names"
+	SafeResourceNames map[string]string `json:"safeResourceNames,omitempty"`
+	// Contains the managed policies (and the namespaces) that have NonCompliant clusters
+	// that require updating.
+	// +operator-sdk:csv:customresourcedefinitions:type=status,displayName="Managed Policies For Upgrade"
+	ManagedPoliciesForUpgrade []ManagedPolicyForUpgrade `json:"managedPoliciesForUpgrade,omitempty"`
+	// +operator-sdk:csv:customresourcedefinitions:type=status,displayName="Managed Policies Compliant Before Upgrade"
+	ManagedPoliciesCompliantBeforeUpgrade []string `json:"managedPoliciesCompliantBeforeUpgrade,omitempty"`
+	// +operator-sdk:csv:customresourcedefinitions:type=status,displayName="Managed Policies Content"
+	ManagedPoliciesContent map[string]string `json:"managedPoliciesContent,omitempty"`
+	// +operator-sdk:csv:customresourcedefinitions:type=status,displayName="Clusters"
+	Clusters []ClusterState `json:"clusters,omitempty"`
+	// +operator-sdk:csv:customresourcedefinitions:type=status,displayName="Status"
+	Status UpgradeStatus `json:"status,omitempty"`
+	// +operator-sdk:csv:customresourcedefinitions:type=status,displayName="Precaching"
+	Precaching *PrecachingStatus `json:"precaching,omitempty"`
+	// +operator-sdk:csv:customresourcedefinitions:type=status,displayName="Backup"
+	Backup *BackupStatus `json:"backup,omitempty"`
+	// +operator-sdk:csv:customresourcedefinitions:type=status,displayName="Computed Maximum Concurrency"
+	ComputedMaxConcurrency int `json:"computedMaxConcurrency,omitempty"`
+	// Number of times each cluster has been retried through spec.retryOf
+	// +operator-sdk:csv:customresourcedefinitions:type=status,displayName="Cluster Retry Counts"
+	ClusterRetryCounts map[string]int `json:"clusterRetryCounts,omitempty"`
+	// The managed policies used from spec.retryOf since the spec doesn't set any
+	// +operator-sdk:csv:customresourcedefinitions:type=status,displayName="Inherited Managed Policies"
+	InheritedManagedPolicies []string `json:"inheritedManagedPolicies,omitempty"`
+	// The manifestwork templates used from spec.retryOf since the spec doesn't set any
+	// +operator-sdk:csv:customresourcedefinitions:type=status,displayName="Inherited ManifestWork Templates"
+	InheritedManifestWorkTemplates []string `json:"inheritedManifestWorkTemplates,omitempty"`
+	// The plan computed when spec.dryRun is set
+	// +operator-sdk:csv:customresourcedefinitions:type=status,displayName="Dry Run"
+	DryRun *DryRunStatus `json:"dryRun,omitempty"`
+	// The rollback of the canary clusters when remediationStrategy.onCanaryFailure is Rollback
+	// +operator-sdk:csv:customresourcedefinitions:type=status,displayName="Rollback"
+	Rollback *RollbackStatus `json:"rollback,omitempty"`
+	// The progress of the remediation when remediationStrategy.mode is Rolling
+	// +operator-sdk:csv:customresourcedefinitions:type=status,displayName="Rolling"
+	Rolling *RollingStatus `json:"rolling,omitempty"`
+}
+
+// +genclient
+//+kubebuilder:object:root=true
+//+kubebuilder:subresource:status
+//+kubebuilder:resource:path=clustergroupupgrades,shortName=cgu
+//+kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+//+kubebuilder:printcolumn:name="State",type="string",JSONPath=".status.conditions[-1:].reason"
+//+kubebuilder:printcolumn:name="Details",type="string",JSONPath=".status.conditions[-1:].message"
+
+// ClusterGroupUpgrade is the Schema for the ClusterGroupUpgrades API
+// +operator-sdk:csv:customresourcedefinitions:displayName="Cluster Group Upgrade",resources={{Namespace, v1},{Deployment,apps/v1}}
+type ClusterGroupUpgrade struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ClusterGroupUpgradeSpec   `json:"spec,omitempty"`
+	Status ClusterGroupUpgradeStatus `json:"status,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// ClusterGroupUpgradeList contains a list of ClusterGroupUpgrade
+type ClusterGroupUpgradeList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ClusterGroupUpgrade `json:"items"`
+}
+
+// PlatformPreCachingSpec modify the default pre-caching behavior and values derived by TALM.
+type PlatformPreCachingSpec struct {
+	// Override the pre-cached operator packages and channels derived by TALM (list of <package:channel> string entries)
+	OperatorsPackagesAndChannels []string `json:"operatorsPackagesAndChannels,omitempty"`
+}
+
+// PreCachingConfigSpec defines the desired state of PreCachingConfig
+type PreCachingConfigSpec struct {
+	// Important: Run "make generate" to regenerate code after modifying this file
+
+	// Overrides modify the default pre-caching behaviour and values derived by TALM.
+	Overrides PlatformPreCachingSpec `json:"overrides,omitempty"`
+	// Amount of space required for the pre-caching job
+	SpaceRequired string `json:"spaceRequired,omitempty"`
+	// List of patterns to exclude from pre-caching
+	ExcludePrecachePatterns []string `json:"excludePrecachePatterns,omitempty"`
+	// List of additional image pull specs for the pre-caching job
+	AdditionalImages []string `json:"additionalImages,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// PreCachingConfig is the Schema for the precachingconfigs API
+type PreCachingConfig struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec PreCachingConfigSpec `json:"spec,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// PreCachingConfigList contains a list of PreCachingConfig
+type PreCachingConfigList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []PreCachingConfig `json:"items"`
+}
@@ -0,0 +1,1130 @@
+//go:build !ignore_autogenerated
+
+/*
+Copyright 2021.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by controller-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	"k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	workv1 "open-cluster-management.io/api/work/v1"
+)
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Actions) DeepCopyInto(out *Actions) {
+	*out = *in
+	if in.BeforeEnable != nil {
+		in, out := &in.BeforeEnable, &out.BeforeEnable
+		*out = new(BeforeEnable)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AfterCompletion != nil {
+		in, out := &in.AfterCompletion, &out.AfterCompletion
+		*out = new(AfterCompletion)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Actions.
+func (in *Actions) DeepCopy() *Actions {
+	if in == nil {
+		return nil
+	}
+	out := new(Actions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AfterCompletion) DeepCopyInto(out *AfterCompletion) {
+	*out = *in
+	if in.AddClusterLabels != nil {
+		in, out := &in.AddClusterLabels, &out.AddClusterLabels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.RemoveClusterLabels != nil {
+		in, out := &in.RemoveClusterLabels, &out.RemoveClusterLabels
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AddClusterAnnotations != nil {
+		in, out := &in.AddClusterAnnotations, &out.AddClusterAnnotations
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.RemoveClusterAnnotations != nil {
+		in, out := &in.RemoveClusterAnnotations, &out.RemoveClusterAnnotations
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.DeleteObjects != nil {
+		in, out := &in.DeleteObjects, &out.DeleteObjects
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AfterCompletion.
+func (in *AfterCompletion) DeepCopy() *AfterCompletion {
+	if in == nil {
+		return nil
+	}
+	out := new(AfterCompletion)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupStatus) DeepCopyInto(out *BackupStatus) {
+	*out = *in
+	in.StartedAt.DeepCopyInto(&out.StartedAt)
+	if in.Status != nil {
+		in, out := &in.Status, &out.Status
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupStatus.
+func (in *BackupStatus) DeepCopy() *BackupStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BeforeEnable) DeepCopyInto(out *BeforeEnable) {
+	*out = *in
+	if in.AddClusterLabels != nil {
+		in, out := &in.AddClusterLabels, &out.AddClusterLabels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.RemoveClusterLabels != nil {
+		in, out := &in.RemoveClusterLabels, &out.RemoveClusterLabels
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AddClusterAnnotations != nil {
+		in, out := &in.AddClusterAnnotations, &out.AddClusterAnnotations
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.RemoveClusterAnnotations != nil {
+		in, out := &in.RemoveClusterAnnotations, &out.RemoveClusterAnnotations
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BeforeEnable.
+func (in *BeforeEnable) DeepCopy() *BeforeEnable {
+	if in == nil {
+		return nil
+	}
+	out := new(BeforeEnable)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BlockingCR) DeepCopyInto(out *BlockingCR) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BlockingCR.
+func (in *BlockingCR) DeepCopy() *BlockingCR {
+	if in == nil {
+		return nil
+	}
+	out := new(BlockingCR)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterGroupUpgrade) DeepCopyInto(out *ClusterGroupUpgrade) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterGroupUpgrade.
+func (in *ClusterGroupUpgrade) DeepCopy() *ClusterGroupUpgrade {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterGroupUpgrade)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterGroupUpgrade) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterGroupUpgradeList) DeepCopyInto(out *ClusterGroupUpgradeList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ClusterGroupUpgrade, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterGroupUpgradeList.
+func (in *ClusterGroupUpgradeList) DeepCopy() *ClusterGroupUpgradeList {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterGroupUpgradeList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterGroupUpgradeList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterGroupUpgradeSpec) DeepCopyInto(out *ClusterGroupUpgradeSpec) {
+	*out = *in
+	out.PreCachingConfigRef = in.PreCachingConfigRef
+	if in.MaintenanceWindows != nil {
+		in, out := &in.MaintenanceWindows, &out.MaintenanceWindows
+		*out = make([]MaintenanceWindow, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.PreflightChecks != nil {
+		in, out := &in.PreflightChecks, &out.PreflightChecks
+		*out = new(HealthChecksSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Verification != nil {
+		in, out := &in.Verification, &out.Verification
+		*out = new(HealthChecksSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Enable != nil {
+		in, out := &in.Enable, &out.Enable
+		*out = new(bool)
+		**out = **in
+	}
+	if in.StartAt != nil {
+		in, out := &in.StartAt, &out.StartAt
+		*out = (*in).DeepCopy()
+	}
+	if in.RetryOf != nil {
+		in, out := &in.RetryOf, &out.RetryOf
+		*out = new(NamespacedCR)
+		**out = **in
+	}
+	if in.Clusters != nil {
+		in, out := &in.Clusters, &out.Clusters
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ClusterLabelSelectors != nil {
+		in, out := &in.ClusterLabelSelectors, &out.ClusterLabelSelectors
+		*out = make([]v1.LabelSelector, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.RemediationStrategy != nil {
+		in, out := &in.RemediationStrategy, &out.RemediationStrategy
+		*out = new(RemediationStrategySpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ManagedPolicies != nil {
+		in, out := &in.ManagedPolicies, &out.ManagedPolicies
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.PolicyOverrides != nil {
+		in, out := &in.PolicyOverrides, &out.PolicyOverrides
+		*out = make([]PolicyOverride, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ManifestWorkTemplates != nil {
+		in, out := &in.ManifestWorkTemplates, &out.ManifestWorkTemplates
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.BlockingCRs != nil {
+		in, out := &in.BlockingCRs, &out.BlockingCRs
+		*out = make([]BlockingCR, len(*in))
+		copy(*out, *in)
+	}
+	in.Actions.DeepCopyInto(&out.Actions)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterGroupUpgradeSpec.
+func (in *ClusterGroupUpgradeSpec) DeepCopy() *ClusterGroupUpgradeSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterGroupUpgradeSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterGroupUpgradeStatus) DeepCopyInto(out *ClusterGroupUpgradeStatus) {
+	*out = *in
+	if in.PlacementBindings != nil {
+		in, out := &in.PlacementBindings, &out.PlacementBindings
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Placements != nil {
+		in, out := &in.Placements, &out.Placements
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.RemediationPlan != nil {
+		in, out := &in.RemediationPlan, &out.RemediationPlan
+		*out = make([][]string, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = make([]string, len(*in))
+				copy(*out, *in)
+			}
+		}
+	}
+	if in.ManagedPoliciesNs != nil {
+		in, out := &in.ManagedPoliciesNs, &out.ManagedPoliciesNs
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.SafeResourceNames != nil {
+		in, out := &in.SafeResourceNames, &out.SafeResourceNames
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.ManagedPoliciesForUpgrade != nil {
+		in, out := &in.ManagedPoliciesForUpgrade, &out.ManagedPoliciesForUpgrade
+		*out = make([]ManagedPolicyForUpgrade, len(*in))
+		copy(*out, *in)
+	}
+	if in.ManagedPoliciesCompliantBeforeUpgrade != nil {
+		in, out := &in.ManagedPoliciesCompliantBeforeUpgrade, &out.ManagedPoliciesCompliantBeforeUpgrade
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ManagedPoliciesContent != nil {
+		in, out := &in.ManagedPoliciesContent, &out.ManagedPoliciesContent
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Clusters != nil {
+		in, out := &in.Clusters, &out.Clusters
+		*out = make([]ClusterState, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	in.Status.DeepCopyInto(&out.Status)
+	if in.Precaching != nil {
+		in, out := &in.Precaching, &out.Precaching
+		*out = new(PrecachingStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Backup != nil {
+		in, out := &in.Backup, &out.Backup
+		*out = new(BackupStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ClusterRetryCounts != nil {
+		in, out := &in.ClusterRetryCounts, &out.ClusterRetryCounts
+		*out = make(map[string]int, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.InheritedManagedPolicies != nil {
+		in, out := &in.InheritedManagedPolicies, &out.InheritedManagedPolicies
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.InheritedManifestWorkTemplates != nil {
+		in, out := &in.InheritedManifestWorkTemplates, &out.InheritedManifestWorkTemplates
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.DryRun != nil {
+		in, out := &in.DryRun, &out.DryRun
+		*out = new(DryRunStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Rollback != nil {
+		in, out := &in.Rollback, &out.Rollback
+		*out = new(RollbackStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Rolling != nil {
+		in, out := &in.Rolling, &out.Rolling
+		*out = new(RollingStatus)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterGroupUpgradeStatus.
+func (in *ClusterGroupUpgradeStatus) DeepCopy() *ClusterGroupUpgradeStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterGroupUpgradeStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterRemediationProgress) DeepCopyInto(out *ClusterRemediationProgress) {
+	*out = *in
+	if in.ManifestWorkIndex != nil {
+		in, out := &in.ManifestWorkIndex, &out.ManifestWorkIndex
+		*out = new(int)
+		**out = **in
+	}
+	if in.PolicyIndex != nil {
+		in, out := &in.PolicyIndex, &out.PolicyIndex
+		*out = new(int)
+		**out = **in
+	}
+	in.FirstCompliantAt.DeepCopyInto(&out.FirstCompliantAt)
+	if in.StartedAt != nil {
+		in, out := &in.StartedAt, &out.StartedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.PolicyStartedAt != nil {
+		in, out := &in.PolicyStartedAt, &out.PolicyStartedAt
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterRemediationProgress.
+func (in *ClusterRemediationProgress) DeepCopy() *ClusterRemediationProgress {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterRemediationProgress)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterRemediationStep) DeepCopyInto(out *ClusterRemediationStep) {
+	*out = *in
+	if in.ManifestWorkStatus != nil {
+		in, out := &in.ManifestWorkStatus, &out.ManifestWorkStatus
+		*out = new(workv1.ManifestResourceStatus)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterRemediationStep.
+func (in *ClusterRemediationStep) DeepCopy() *ClusterRemediationStep {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterRemediationStep)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterState) DeepCopyInto(out *ClusterState) {
+	*out = *in
+	if in.LastStep != nil {
+		in, out := &in.LastStep, &out.LastStep
+		*out = new(ClusterRemediationStep)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterState.
+func (in *ClusterState) DeepCopy() *ClusterState {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterState)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DryRunClusterPlan) DeepCopyInto(out *DryRunClusterPlan) {
+	*out = *in
+	if in.NonCompliantPolicies != nil {
+		in, out := &in.NonCompliantPolicies, &out.NonCompliantPolicies
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.SubscriptionsToApprove != nil {
+		in, out := &in.SubscriptionsToApprove, &out.SubscriptionsToApprove
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DryRunClusterPlan.
+func (in *DryRunClusterPlan) DeepCopy() *DryRunClusterPlan {
+	if in == nil {
+		return nil
+	}
+	out := new(DryRunClusterPlan)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DryRunStatus) DeepCopyInto(out *DryRunStatus) {
+	*out = *in
+	in.ComputedAt.DeepCopyInto(&out.ComputedAt)
+	if in.SelectedClusters != nil {
+		in, out := &in.SelectedClusters, &out.SelectedClusters
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.CompliantClusters != nil {
+		in, out := &in.CompliantClusters, &out.CompliantClusters
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Batches != nil {
+		in, out := &in.Batches, &out.Batches
+		*out = make([][]string, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = make([]string, len(*in))
+				copy(*out, *in)
+			}
+		}
+	}
+	if in.Clusters != nil {
+		in, out := &in.Clusters, &out.Clusters
+		*out = make([]DryRunClusterPlan, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DryRunStatus.
+func (in *DryRunStatus) DeepCopy() *DryRunStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DryRunStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExpectedCondition) DeepCopyInto(out *ExpectedCondition) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExpectedCondition.
+func (in *ExpectedCondition) DeepCopy() *ExpectedCondition {
+	if in == nil {
+		return nil
+	}
+	out := new(ExpectedCondition)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HealthChecksSpec) DeepCopyInto(out *HealthChecksSpec) {
+	*out = *in
+	if in.MachineConfigPoolNames != nil {
+		in, out := &in.MachineConfigPoolNames, &out.MachineConfigPoolNames
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = make([]ResourceConditionCheck, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HealthChecksSpec.
+func (in *HealthChecksSpec) DeepCopy() *HealthChecksSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(HealthChecksSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MaintenanceWindow) DeepCopyInto(out *MaintenanceWindow) {
+	*out = *in
+	if in.DaysOfWeek != nil {
+		in, out := &in.DaysOfWeek, &out.DaysOfWeek
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	out.Duration = in.Duration
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MaintenanceWindow.
+func (in *MaintenanceWindow) DeepCopy() *MaintenanceWindow {
+	if in == nil {
+		return nil
+	}
+	out := new(MaintenanceWindow)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ManagedPolicyForUpgrade) DeepCopyInto(out *ManagedPolicyForUpgrade) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagedPolicyForUpgrade.
+func (in *ManagedPolicyForUpgrade) DeepCopy() *ManagedPolicyForUpgrade {
+	if in == nil {
+		return nil
+	}
+	out := new(ManagedPolicyForUpgrade)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ManifestWorkStatus) DeepCopyInto(out *ManifestWorkStatus) {
+	*out = *in
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManifestWorkStatus.
+func (in *ManifestWorkStatus) DeepCopy() *ManifestWorkStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ManifestWorkStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NamespacedCR) DeepCopyInto(out *NamespacedCR) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NamespacedCR.
+func (in *NamespacedCR) DeepCopy() *NamespacedCR {
+	if in == nil {
+		return nil
+	}
+	out := new(NamespacedCR)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OperatorUpgradeSpec) DeepCopyInto(out *OperatorUpgradeSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OperatorUpgradeSpec.
+func (in *OperatorUpgradeSpec) DeepCopy() *OperatorUpgradeSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(OperatorUpgradeSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PlatformPreCachingSpec) DeepCopyInto(out *PlatformPreCachingSpec) {
+	*out = *in
+	if in.OperatorsPackagesAndChannels != nil {
+		in, out := &in.OperatorsPackagesAndChannels, &out.OperatorsPackagesAndChannels
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PlatformPreCachingSpec.
+func (in *PlatformPreCachingSpec) DeepCopy() *PlatformPreCachingSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(PlatformPreCachingSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PolicyOverride) DeepCopyInto(out *PolicyOverride) {
+	*out = *in
+	if in.SoakSeconds != nil {
+		in, out := &in.SoakSeconds, &out.SoakSeconds
+		*out = new(int)
+		**out = **in
+	}
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(int)
+		**out = **in
+	}
+	if in.SkipIfCompliant != nil {
+		in, out := &in.SkipIfCompliant, &out.SkipIfCompliant
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PolicyOverride.
+func (in *PolicyOverride) DeepCopy() *PolicyOverride {
+	if in == nil {
+		return nil
+	}
+	out := new(PolicyOverride)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PolicyStatus) DeepCopyInto(out *PolicyStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PolicyStatus.
+func (in *PolicyStatus) DeepCopy() *PolicyStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(PolicyStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PreCachingConfig) DeepCopyInto(out *PreCachingConfig) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PreCachingConfig.
+func (in *PreCachingConfig) DeepCopy() *PreCachingConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(PreCachingConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PreCachingConfig) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PreCachingConfigCR) DeepCopyInto(out *PreCachingConfigCR) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PreCachingConfigCR.
+func (in *PreCachingConfigCR) DeepCopy() *PreCachingConfigCR {
+	if in == nil {
+		return nil
+	}
+	out := new(PreCachingConfigCR)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PreCachingConfigList) DeepCopyInto(out *PreCachingConfigList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]PreCachingConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PreCachingConfigList.
+func (in *PreCachingConfigList) DeepCopy() *PreCachingConfigList {
+	if in == nil {
+		return nil
+	}
+	out := new(PreCachingConfigList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PreCachingConfigList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PreCachingConfigSpec) DeepCopyInto(out *PreCachingConfigSpec) {
+	*out = *in
+	in.Overrides.DeepCopyInto(&out.Overrides)
+	if in.ExcludePrecachePatterns != nil {
+		in, out := &in.ExcludePrecachePatterns, &out.ExcludePrecachePatterns
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AdditionalImages != nil {
+		in, out := &in.AdditionalImages, &out.AdditionalImages
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PreCachingConfigSpec.
+func (in *PreCachingConfigSpec) DeepCopy() *PreCachingConfigSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(PreCachingConfigSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PrecachingSpec) DeepCopyInto(out *PrecachingSpec) {
+	*out = *in
+	if in.OperatorsIndexes != nil {
+		in, out := &in.OperatorsIndexes, &out.OperatorsIndexes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.OperatorsPackagesAndChannels != nil {
+		in, out := &in.OperatorsPackagesAndChannels, &out.OperatorsPackagesAndChannels
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ExcludePrecachePatterns != nil {
+		in, out := &in.ExcludePrecachePatterns, &out.ExcludePrecachePatterns
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AdditionalImages != nil {
+		in, out := &in.AdditionalImages, &out.AdditionalImages
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PrecachingSpec.
+func (in *PrecachingSpec) DeepCopy() *PrecachingSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(PrecachingSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PrecachingStatus) DeepCopyInto(out *PrecachingStatus) {
+	*out = *in
+	if in.Spec != nil {
+		in, out := &in.Spec, &out.Spec
+		*out = new(PrecachingSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Status != nil {
+		in, out := &in.Status, &out.Status
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PrecachingStatus.
+func (in *PrecachingStatus) DeepCopy() *PrecachingStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(PrecachingStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RemediationStrategySpec) DeepCopyInto(out *RemediationStrategySpec) {
+	*out = *in
+	if in.Canaries != nil {
+		in, out := &in.Canaries, &out.Canaries
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.RollbackPolicies != nil {
+		in, out := &in.RollbackPolicies, &out.RollbackPolicies
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	out.MaxConcurrency = in.MaxConcurrency
+	if in.BatchSizes != nil {
+		in, out := &in.BatchSizes, &out.BatchSizes
+		*out = make([]intstr.IntOrString, len(*in))
+		copy(*out, *in)
+	}
+	if in.SpreadBy != nil {
+		in, out := &in.SpreadBy, &out.SpreadBy
+		*out = new(SpreadBySpec)
+		**out = **in
+	}
+	if in.UnavailableClusters != nil {
+		in, out := &in.UnavailableClusters, &out.UnavailableClusters
+		*out = new(UnavailableClustersSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MaxFailures != nil {
+		in, out := &in.MaxFailures, &out.MaxFailures
+		*out = new(intstr.IntOrString)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RemediationStrategySpec.
+func (in *RemediationStrategySpec) DeepCopy() *RemediationStrategySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(RemediationStrategySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceConditionCheck) DeepCopyInto(out *ResourceConditionCheck) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]ExpectedCondition, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceConditionCheck.
+func (in *ResourceConditionCheck) DeepCopy() *ResourceConditionCheck {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceConditionCheck)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RollbackStatus) DeepCopyInto(out *RollbackStatus) {
+	*out = *in
+	in.StartedAt.DeepCopyInto(&out.StartedAt)
+	if in.CompletedAt != nil {
+		in, out := &in.CompletedAt, &out.CompletedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.Clusters != nil {
+		in, out := &in.Clusters, &out.Clusters
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Policies != nil {
+		in, out := &in.Policies, &out.Policies
+		*out = make([]ManagedPolicyForUpgrade, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RollbackStatus.
+func (in *RollbackStatus) DeepCopy() *RollbackStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(RollbackStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RollingStatus) DeepCopyInto(out *RollingStatus) {
+	*out = *in
+	if in.InFlightClusters != nil {
+		in, out := &in.InFlightClusters, &out.InFlightClusters
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RollingStatus.
+func (in *RollingStatus) DeepCopy() *RollingStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(RollingStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SpreadBySpec) DeepCopyInto(out *SpreadBySpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SpreadBySpec.
+func (in *SpreadBySpec) DeepCopy() *SpreadBySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(SpreadBySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UnavailableClustersSpec) DeepCopyInto(out *UnavailableClustersSpec) {
+	*out = *in
+	if in.MaxLeaseAge != nil {
+		in, out := &in.MaxLeaseAge, &out.MaxLeaseAge
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UnavailableClustersSpec.
+func (in *UnavailableClustersSpec) DeepCopy() *UnavailableClustersSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(UnavailableClustersSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UpgradeStatus) DeepCopyInto(out *UpgradeStatus) {
+	*out = *in
+	in.StartedAt.DeepCopyInto(&out.StartedAt)
+	in.CompletedAt.DeepCopyInto(&out.CompletedAt)
+	in.CurrentBatchStartedAt.DeepCopyInto(&out.CurrentBatchStartedAt)
+	if in.PausedAt != nil {
+		in, out := &in.PausedAt, &out.PausedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.WaitingForMaintenanceWindowSince != nil {
+		in, out := &in.WaitingForMaintenanceWindowSince, &out.WaitingForMaintenanceWindowSince
+		*out = (*in).DeepCopy()
+	}
+	if in.CurrentBatchRemediationProgress != nil {
+		in, out := &in.CurrentBatchRemediationProgress, &out.CurrentBatchRemediationProgress
+		*out = make(map[string]*ClusterRemediationProgress, len(*in))
+		for key, val := range *in {
+			var outVal *ClusterRemediationProgress
+			if val == nil {
+				(*out)[key] = nil
+			} else {
+				inVal := (*in)[key]
+				in, out := &inVal, &outVal
+				*out = new(ClusterRemediationProgress)
+				(*in).DeepCopyInto(*out)
+			}
+			(*out)[key] = outVal
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UpgradeStatus.
+func (in *UpgradeStatus) DeepCopy() *UpgradeStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(UpgradeStatus)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -27,14 +27,24 @@ type ClusterGroupUpgradeSpecApplyConfiguration struct {
 	Backup                *bool                                      `json:"backup,omitempty"`
 	PreCaching            *bool                                      `json:"preCaching,omitempty"`
 	PreCachingConfigRef   *PreCachingConfigCRApplyConfiguration      `json:"preCachingConfigRef,omitempty"`
+	MaintenanceWindows    []MaintenanceWindowApplyConfiguration      `json:"maintenanceWindows,omitempty"`
+	PreflightChecks       *HealthChecksSpecApplyConfiguration        `json:"preflightChecks,omitempty"`
+	Verification          *HealthChecksSpecApplyConfiguration        `json:"verification,omitempty"`
 	Enable                *bool                                      `json:"enable,omitempty"`
+	StartAt               *v1.Time                                   `json:"startAt,omitempty"`
+	DryRun                *bool                                      `json:"dryRun,omitempty"`
+	DynamicMembership     *bool                                      `json:"dynamicMembership,omitempty"`
+	RetryOf               *NamespacedCRApplyConfiguration            `json:"retryOf,omitempty"`
 	Clusters              []string                                   `json:"clusters,omitempty"`
 	ClusterSelector       []string                                   `json:"clusterSelector,omitempty"`
 	ClusterLabelSelectors []v1.LabelSelector                         `json:"clusterLabelSelectors,omitempty"`
 	RemediationStrategy   *RemediationStrategySpecApplyConfiguration `json:"remediationStrategy,omitempty"`
 	ManagedPolicies       []string                                   `json:"managedPolicies,omitempty"`
+	PolicyOverrides       []PolicyOverrideApplyConfiguration         `json:"policyOverrides,omitempty"`
 	ManifestWorkTemplates []string                                   `json:"manifestWorkTemplates,omitempty"`
 	BlockingCRs           []BlockingCRApplyConfiguration             `json:"blockingCRs,omitempty"`
+	Priority              *int                                       `json:"priority,omitempty"`
+	ConflictAction        *string                                    `json:"conflictAction,omitempty"`
 	Actions               *ActionsApplyConfiguration                 `json:"actions,omitempty"`
 	BatchTimeoutAction    *string                                    `json:"batchTimeoutAction,omitempty"`
 }
@@ -69,6 +79,35 @@ func (b *ClusterGroupUpgradeSpecApplyConfiguration) WithPreCachingConfigRef(valu
 	return b
 }
 
+// WithMaintenanceWindows adds the given value to the MaintenanceWindows field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the MaintenanceWindows field.
+func (b *ClusterGroupUpgradeSpecApplyConfiguration) WithMaintenanceWindows(values ...*MaintenanceWindowApplyConfiguration) *ClusterGroupUpgradeSpecApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithMaintenanceWindows")
+		}
+		b.MaintenanceWindows = append(b.MaintenanceWindows, *values[i])
+	}
+	return b
+}
+
+// WithPreflightChecks sets the PreflightChecks field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the PreflightChecks field is set to the value of the last call.
+func (b *ClusterGroupUpgradeSpecApplyConfiguration) WithPreflightChecks(value *HealthChecksSpecApplyConfiguration) *ClusterGroupUpgradeSpecApplyConfiguration {
+	b.PreflightChecks = value
+	return b
+}
+
+// WithVerification sets the Verification field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Verification field is set to the value of the last call.
+func (b *ClusterGroupUpgradeSpecApplyConfiguration) WithVerification(value *HealthChecksSpecApplyConfiguration) *ClusterGroupUpgradeSpecApplyConfiguration {
+	b.Verification = value
+	return b
+}
+
 // WithEnable sets the Enable field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the Enable field is set to the value of the last call.
@@ -77,6 +116,38 @@ func (b *ClusterGroupUpgradeSpecApplyConfiguration) WithEnable(value bool) *Clus
 	return b
 }
 
+// WithStartAt sets the StartAt field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the StartAt field is set to the value of the last call.
+func (b *ClusterGroupUpgradeSpecApplyConfiguration) WithStartAt(value v1.Time) *ClusterGroupUpgradeSpecApplyConfiguration {
+	b.StartAt = &value
+	return b
+}
+
+// WithDryRun sets the DryRun field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the DryRun field is set to the value of the last call.
+func (b *ClusterGroupUpgradeSpecApplyConfiguration) WithDryRun(value bool) *ClusterGroupUpgradeSpecApplyConfiguration {
+	b.DryRun = &value
+	return b
+}
+
+// WithDynamicMembership sets the DynamicMembership field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the DynamicMembership field is set to the value of the last call.
+func (b *ClusterGroupUpgradeSpecApplyConfiguration) WithDynamicMembership(value bool) *ClusterGroupUpgradeSpecApplyConfiguration {
+	b.DynamicMembership = &value
+	return b
+}
+
+// WithRetryOf sets the RetryOf field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the RetryOf field is set to the value of the last call.
+func (b *ClusterGroupUpgradeSpecApplyConfiguration) WithRetryOf(value *NamespacedCRApplyConfiguration) *ClusterGroupUpgradeSpecApplyConfiguration {
+	b.RetryOf = value
+	return b
+}
+
 // WithClusters adds the given value to the Clusters field in the declarative configuration
 // and returns the receiver, so that objects can be build by chaining "With" function invocations.
 // If called multiple times, values provided by each call will be appended to the Clusters field.
@@ -125,6 +196,19 @@ func (b *ClusterGroupUpgradeSpecApplyConfiguration) WithManagedPolicies(values .
 	return b
 }
 
+// WithPolicyOverrides adds the given value to the PolicyOverrides field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the PolicyOverrides field.
+func (b *ClusterGroupUpgradeSpecApplyConfiguration) WithPolicyOverrides(values ...*PolicyOverrideApplyConfiguration) *ClusterGroupUpgradeSpecApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithPolicyOverrides")
+		}
+		b.PolicyOverrides = append(b.PolicyOverrides, *values[i])
+	}
+	return b
+}
+
 // WithManifestWorkTemplates adds the given value to the ManifestWorkTemplates field in the declarative configuration
 // and returns the receiver, so that objects can be build by chaining "With" function invocations.
 // If called multiple times, values provided by each call will be appended to the ManifestWorkTemplates field.
@@ -148,6 +232,22 @@ func (b *ClusterGroupUpgradeSpecApplyConfiguration) WithBlockingCRs(values ...*B
 	return b
 }
 
+// WithPriority sets the Priority field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Priority field is set to the value of the last call.
+func (b *ClusterGroupUpgradeSpecApplyConfiguration) WithPriority(value int) *ClusterGroupUpgradeSpecApplyConfiguration {
+	b.Priority = &value
+	return b
+}
+
+// WithConflictAction sets the ConflictAction field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ConflictAction field is set to the value of the last call.
+func (b *ClusterGroupUpgradeSpecApplyConfiguration) WithConflictAction(value string) *ClusterGroupUpgradeSpecApplyConfiguration {
+	b.ConflictAction = &value
+	return b
+}
+
 // WithActions sets the Actions field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the Actions field is set to the value of the last call.
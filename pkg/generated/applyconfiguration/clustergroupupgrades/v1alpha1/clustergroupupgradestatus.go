@@ -39,6 +39,12 @@ type ClusterGroupUpgradeStatusApplyConfiguration struct {
 	Precaching                            *PrecachingStatusApplyConfiguration         `json:"precaching,omitempty"`
 	Backup                                *BackupStatusApplyConfiguration             `json:"backup,omitempty"`
 	ComputedMaxConcurrency                *int                                        `json:"computedMaxConcurrency,omitempty"`
+	ClusterRetryCounts                    map[string]int                              `json:"clusterRetryCounts,omitempty"`
+	InheritedManagedPolicies              []string                                    `json:"inheritedManagedPolicies,omitempty"`
+	InheritedManifestWorkTemplates        []string                                    `json:"inheritedManifestWorkTemplates,omitempty"`
+	DryRun                                *DryRunStatusApplyConfiguration             `json:"dryRun,omitempty"`
+	Rollback                              *RollbackStatusApplyConfiguration           `json:"rollback,omitempty"`
+	Rolling                               *RollingStatusApplyConfiguration            `json:"rolling,omitempty"`
 }
 
 // ClusterGroupUpgradeStatusApplyConfiguration constructs an declarative configuration of the ClusterGroupUpgradeStatus type for use with
@@ -206,3 +212,61 @@ func (b *ClusterGroupUpgradeStatusApplyConfiguration) WithComputedMaxConcurrency
 	b.ComputedMaxConcurrency = &value
 	return b
 }
+
+// WithClusterRetryCounts puts the entries into the ClusterRetryCounts field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, the entries provided by each call will be put on the ClusterRetryCounts field,
+// overwriting an existing map entries in ClusterRetryCounts field with the same key.
+func (b *ClusterGroupUpgradeStatusApplyConfiguration) WithClusterRetryCounts(entries map[string]int) *ClusterGroupUpgradeStatusApplyConfiguration {
+	if b.ClusterRetryCounts == nil && len(entries) > 0 {
+		b.ClusterRetryCounts = make(map[string]int, len(entries))
+	}
+	for k, v := range entries {
+		b.ClusterRetryCounts[k] = v
+	}
+	return b
+}
+
+// WithInheritedManagedPolicies adds the given value to the InheritedManagedPolicies field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the InheritedManagedPolicies field.
+func (b *ClusterGroupUpgradeStatusApplyConfiguration) WithInheritedManagedPolicies(values ...string) *ClusterGroupUpgradeStatusApplyConfiguration {
+	for i := range values {
+		b.InheritedManagedPolicies = append(b.InheritedManagedPolicies, values[i])
+	}
+	return b
+}
+
+// WithInheritedManifestWorkTemplates adds the given value to the InheritedManifestWorkTemplates field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the InheritedManifestWorkTemplates field.
+func (b *ClusterGroupUpgradeStatusApplyConfiguration) WithInheritedManifestWorkTemplates(values ...string) *ClusterGroupUpgradeStatusApplyConfiguration {
+	for i := range values {
+		b.InheritedManifestWorkTemplates = append(b.InheritedManifestWorkTemplates, values[i])
+	}
+	return b
+}
+
+// WithDryRun sets the DryRun field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the DryRun field is set to the value of the last call.
+func (b *ClusterGroupUpgradeStatusApplyConfiguration) WithDryRun(value *DryRunStatusApplyConfiguration) *ClusterGroupUpgradeStatusApplyConfiguration {
+	b.DryRun = value
+	return b
+}
+
+// WithRollback sets the Rollback field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Rollback field is set to the value of the last call.
+func (b *ClusterGroupUpgradeStatusApplyConfiguration) WithRollback(value *RollbackStatusApplyConfiguration) *ClusterGroupUpgradeStatusApplyConfiguration {
+	b.Rollback = value
+	return b
+}
+
+// WithRolling sets the Rolling field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Rolling field is set to the value of the last call.
+func (b *ClusterGroupUpgradeStatusApplyConfiguration) WithRolling(value *RollingStatusApplyConfiguration) *ClusterGroupUpgradeStatusApplyConfiguration {
+	b.Rolling = value
+	return b
+}
@@ -28,6 +28,8 @@ type ClusterRemediationProgressApplyConfiguration struct {
 	ManifestWorkIndex *int     `json:"manifestWorkIndex,omitempty"`
 	PolicyIndex       *int     `json:"policyIndex,omitempty"`
 	FirstCompliantAt  *v1.Time `json:"firstCompliantAt,omitempty"`
+	StartedAt         *v1.Time `json:"startedAt,omitempty"`
+	PolicyStartedAt   *v1.Time `json:"policyStartedAt,omitempty"`
 }
 
 // ClusterRemediationProgressApplyConfiguration constructs an declarative configuration of the ClusterRemediationProgress type for use with
@@ -67,3 +69,19 @@ func (b *ClusterRemediationProgressApplyConfiguration) WithFirstCompliantAt(valu
 	b.FirstCompliantAt = &value
 	return b
 }
+
+// WithStartedAt sets the StartedAt field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the StartedAt field is set to the value of the last call.
+func (b *ClusterRemediationProgressApplyConfiguration) WithStartedAt(value v1.Time) *ClusterRemediationProgressApplyConfiguration {
+	b.StartedAt = &value
+	return b
+}
+
+// WithPolicyStartedAt sets the PolicyStartedAt field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the PolicyStartedAt field is set to the value of the last call.
+func (b *ClusterRemediationProgressApplyConfiguration) WithPolicyStartedAt(value v1.Time) *ClusterRemediationProgressApplyConfiguration {
+	b.PolicyStartedAt = &value
+	return b
+}
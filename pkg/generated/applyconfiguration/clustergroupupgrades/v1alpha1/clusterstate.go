@@ -22,6 +22,7 @@ package v1alpha1
 type ClusterStateApplyConfiguration struct {
 	Name                *string                               `json:"name,omitempty"`
 	State               *string                               `json:"state,omitempty"`
+	Reason              *string                               `json:"reason,omitempty"`
 	CurrentPolicy       *PolicyStatusApplyConfiguration       `json:"currentPolicy,omitempty"`
 	CurrentManifestWork *ManifestWorkStatusApplyConfiguration `json:"currentManifestWork,omitempty"`
 }
@@ -48,6 +49,14 @@ func (b *ClusterStateApplyConfiguration) WithState(value string) *ClusterStateAp
 	return b
 }
 
+// WithReason sets the Reason field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Reason field is set to the value of the last call.
+func (b *ClusterStateApplyConfiguration) WithReason(value string) *ClusterStateApplyConfiguration {
+	b.Reason = &value
+	return b
+}
+
 // WithCurrentPolicy sets the CurrentPolicy field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the CurrentPolicy field is set to the value of the last call.
@@ -0,0 +1,51 @@
+/*
+Copyright 2021.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ExpectedConditionApplyConfiguration represents an declarative configuration of the ExpectedCondition type for use
+// with apply.
+type ExpectedConditionApplyConfiguration struct {
+	Type   *string             `json:"type,omitempty"`
+	Status *v1.ConditionStatus `json:"status,omitempty"`
+}
+
+// ExpectedConditionApplyConfiguration constructs an declarative configuration of the ExpectedCondition type for use with
+// apply.
+func ExpectedCondition() *ExpectedConditionApplyConfiguration {
+	return &ExpectedConditionApplyConfiguration{}
+}
+
+// WithType sets the Type field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Type field is set to the value of the last call.
+func (b *ExpectedConditionApplyConfiguration) WithType(value string) *ExpectedConditionApplyConfiguration {
+	b.Type = &value
+	return b
+}
+
+// WithStatus sets the Status field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Status field is set to the value of the last call.
+func (b *ExpectedConditionApplyConfiguration) WithStatus(value v1.ConditionStatus) *ExpectedConditionApplyConfiguration {
+	b.Status = &value
+	return b
+}
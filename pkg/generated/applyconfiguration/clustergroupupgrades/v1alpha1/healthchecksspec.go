@@ -0,0 +1,99 @@
+/*
+Copyright 2021.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+// HealthChecksSpecApplyConfiguration represents an declarative configuration of the HealthChecksSpec type for use
+// with apply.
+type HealthChecksSpecApplyConfiguration struct {
+	ClusterVersion          *bool                                      `json:"clusterVersion,omitempty"`
+	ClusterVersionAvailable *bool                                      `json:"clusterVersionAvailable,omitempty"`
+	MachineConfigPools      *bool                                      `json:"machineConfigPools,omitempty"`
+	Nodes                   *bool                                      `json:"nodes,omitempty"`
+	Etcd                    *bool                                      `json:"etcd,omitempty"`
+	MachineConfigPoolNames  []string                                   `json:"machineConfigPoolNames,omitempty"`
+	Resources               []ResourceConditionCheckApplyConfiguration `json:"resources,omitempty"`
+}
+
+// HealthChecksSpecApplyConfiguration constructs an declarative configuration of the HealthChecksSpec type for use with
+// apply.
+func HealthChecksSpec() *HealthChecksSpecApplyConfiguration {
+	return &HealthChecksSpecApplyConfiguration{}
+}
+
+// WithClusterVersion sets the ClusterVersion field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ClusterVersion field is set to the value of the last call.
+func (b *HealthChecksSpecApplyConfiguration) WithClusterVersion(value bool) *HealthChecksSpecApplyConfiguration {
+	b.ClusterVersion = &value
+	return b
+}
+
+// WithClusterVersionAvailable sets the ClusterVersionAvailable field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ClusterVersionAvailable field is set to the value of the last call.
+func (b *HealthChecksSpecApplyConfiguration) WithClusterVersionAvailable(value bool) *HealthChecksSpecApplyConfiguration {
+	b.ClusterVersionAvailable = &value
+	return b
+}
+
+// WithMachineConfigPools sets the MachineConfigPools field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MachineConfigPools field is set to the value of the last call.
+func (b *HealthChecksSpecApplyConfiguration) WithMachineConfigPools(value bool) *HealthChecksSpecApplyConfiguration {
+	b.MachineConfigPools = &value
+	return b
+}
+
+// WithNodes sets the Nodes field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Nodes field is set to the value of the last call.
+func (b *HealthChecksSpecApplyConfiguration) WithNodes(value bool) *HealthChecksSpecApplyConfiguration {
+	b.Nodes = &value
+	return b
+}
+
+// WithEtcd sets the Etcd field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Etcd field is set to the value of the last call.
+func (b *HealthChecksSpecApplyConfiguration) WithEtcd(value bool) *HealthChecksSpecApplyConfiguration {
+	b.Etcd = &value
+	return b
+}
+
+// WithMachineConfigPoolNames adds the given value to the MachineConfigPoolNames field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the MachineConfigPoolNames field.
+func (b *HealthChecksSpecApplyConfiguration) WithMachineConfigPoolNames(values ...string) *HealthChecksSpecApplyConfiguration {
+	for i := range values {
+		b.MachineConfigPoolNames = append(b.MachineConfigPoolNames, values[i])
+	}
+	return b
+}
+
+// WithResources adds the given value to the Resources field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Resources field.
+func (b *HealthChecksSpecApplyConfiguration) WithResources(values ...*ResourceConditionCheckApplyConfiguration) *HealthChecksSpecApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithResources")
+		}
+		b.Resources = append(b.Resources, *values[i])
+	}
+	return b
+}
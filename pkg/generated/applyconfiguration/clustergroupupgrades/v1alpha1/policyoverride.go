@@ -0,0 +1,65 @@
+/*
+Copyright 2021.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+// PolicyOverrideApplyConfiguration represents an declarative configuration of the PolicyOverride type for use
+// with apply.
+type PolicyOverrideApplyConfiguration struct {
+	Name            *string `json:"name,omitempty"`
+	SoakSeconds     *int    `json:"soakSeconds,omitempty"`
+	Timeout         *int    `json:"timeout,omitempty"`
+	SkipIfCompliant *bool   `json:"skipIfCompliant,omitempty"`
+}
+
+// PolicyOverrideApplyConfiguration constructs an declarative configuration of the PolicyOverride type for use with
+// apply.
+func PolicyOverride() *PolicyOverrideApplyConfiguration {
+	return &PolicyOverrideApplyConfiguration{}
+}
+
+// WithName sets the Name field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Name field is set to the value of the last call.
+func (b *PolicyOverrideApplyConfiguration) WithName(value string) *PolicyOverrideApplyConfiguration {
+	b.Name = &value
+	return b
+}
+
+// WithSoakSeconds sets the SoakSeconds field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SoakSeconds field is set to the value of the last call.
+func (b *PolicyOverrideApplyConfiguration) WithSoakSeconds(value int) *PolicyOverrideApplyConfiguration {
+	b.SoakSeconds = &value
+	return b
+}
+
+// WithTimeout sets the Timeout field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Timeout field is set to the value of the last call.
+func (b *PolicyOverrideApplyConfiguration) WithTimeout(value int) *PolicyOverrideApplyConfiguration {
+	b.Timeout = &value
+	return b
+}
+
+// WithSkipIfCompliant sets the SkipIfCompliant field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SkipIfCompliant field is set to the value of the last call.
+func (b *PolicyOverrideApplyConfiguration) WithSkipIfCompliant(value bool) *PolicyOverrideApplyConfiguration {
+	b.SkipIfCompliant = &value
+	return b
+}
@@ -17,12 +17,26 @@ limitations under the License.
 
 package v1alpha1
 
+import (
+	intstr "k8s.io/apimachinery/pkg/util/intstr"
+)
+
 // RemediationStrategySpecApplyConfiguration represents an declarative configuration of the RemediationStrategySpec type for use
 // with apply.
 type RemediationStrategySpecApplyConfiguration struct {
-	Canaries       []string `json:"canaries,omitempty"`
-	MaxConcurrency *int     `json:"maxConcurrency,omitempty"`
-	Timeout        *int     `json:"timeout,omitempty"`
+	Canaries                 []string                                   `json:"canaries,omitempty"`
+	OnCanaryFailure          *string                                    `json:"onCanaryFailure,omitempty"`
+	RollbackPolicies         []string                                   `json:"rollbackPolicies,omitempty"`
+	Mode                     *string                                    `json:"mode,omitempty"`
+	MaxConcurrency           *int                                       `json:"maxConcurrency,omitempty"`
+	MaxConcurrencyPercentage *int                                       `json:"maxConcurrencyPercentage,omitempty"`
+	BatchSizes               []intstr.IntOrString                       `json:"batchSizes,omitempty"`
+	SpreadBy                 *SpreadBySpecApplyConfiguration            `json:"spreadBy,omitempty"`
+	BatchBy                  *string                                    `json:"batchBy,omitempty"`
+	UnavailableClusters      *UnavailableClustersSpecApplyConfiguration `json:"unavailableClusters,omitempty"`
+	Timeout                  *int                                       `json:"timeout,omitempty"`
+	ClusterTimeout           *int                                       `json:"clusterTimeout,omitempty"`
+	MaxFailures              *intstr.IntOrString                        `json:"maxFailures,omitempty"`
 }
 
 // RemediationStrategySpecApplyConfiguration constructs an declarative configuration of the RemediationStrategySpec type for use with
@@ -41,6 +55,32 @@ func (b *RemediationStrategySpecApplyConfiguration) WithCanaries(values ...strin
 	return b
 }
 
+// WithOnCanaryFailure sets the OnCanaryFailure field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the OnCanaryFailure field is set to the value of the last call.
+func (b *RemediationStrategySpecApplyConfiguration) WithOnCanaryFailure(value string) *RemediationStrategySpecApplyConfiguration {
+	b.OnCanaryFailure = &value
+	return b
+}
+
+// WithRollbackPolicies adds the given value to the RollbackPolicies field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the RollbackPolicies field.
+func (b *RemediationStrategySpecApplyConfiguration) WithRollbackPolicies(values ...string) *RemediationStrategySpecApplyConfiguration {
+	for i := range values {
+		b.RollbackPolicies = append(b.RollbackPolicies, values[i])
+	}
+	return b
+}
+
+// WithMode sets the Mode field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Mode field is set to the value of the last call.
+func (b *RemediationStrategySpecApplyConfiguration) WithMode(value string) *RemediationStrategySpecApplyConfiguration {
+	b.Mode = &value
+	return b
+}
+
 // WithMaxConcurrency sets the MaxConcurrency field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the MaxConcurrency field is set to the value of the last call.
@@ -49,6 +89,48 @@ func (b *RemediationStrategySpecApplyConfiguration) WithMaxConcurrency(value int
 	return b
 }
 
+// WithMaxConcurrencyPercentage sets the MaxConcurrencyPercentage field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MaxConcurrencyPercentage field is set to the value of the last call.
+func (b *RemediationStrategySpecApplyConfiguration) WithMaxConcurrencyPercentage(value int) *RemediationStrategySpecApplyConfiguration {
+	b.MaxConcurrencyPercentage = &value
+	return b
+}
+
+// WithBatchSizes adds the given value to the BatchSizes field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the BatchSizes field.
+func (b *RemediationStrategySpecApplyConfiguration) WithBatchSizes(values ...intstr.IntOrString) *RemediationStrategySpecApplyConfiguration {
+	for i := range values {
+		b.BatchSizes = append(b.BatchSizes, values[i])
+	}
+	return b
+}
+
+// WithSpreadBy sets the SpreadBy field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SpreadBy field is set to the value of the last call.
+func (b *RemediationStrategySpecApplyConfiguration) WithSpreadBy(value *SpreadBySpecApplyConfiguration) *RemediationStrategySpecApplyConfiguration {
+	b.SpreadBy = value
+	return b
+}
+
+// WithBatchBy sets the BatchBy field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the BatchBy field is set to the value of the last call.
+func (b *RemediationStrategySpecApplyConfiguration) WithBatchBy(value string) *RemediationStrategySpecApplyConfiguration {
+	b.BatchBy = &value
+	return b
+}
+
+// WithUnavailableClusters sets the UnavailableClusters field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the UnavailableClusters field is set to the value of the last call.
+func (b *RemediationStrategySpecApplyConfiguration) WithUnavailableClusters(value *UnavailableClustersSpecApplyConfiguration) *RemediationStrategySpecApplyConfiguration {
+	b.UnavailableClusters = value
+	return b
+}
+
 // WithTimeout sets the Timeout field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the Timeout field is set to the value of the last call.
@@ -56,3 +138,19 @@ func (b *RemediationStrategySpecApplyConfiguration) WithTimeout(value int) *Reme
 	b.Timeout = &value
 	return b
 }
+
+// WithClusterTimeout sets the ClusterTimeout field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ClusterTimeout field is set to the value of the last call.
+func (b *RemediationStrategySpecApplyConfiguration) WithClusterTimeout(value int) *RemediationStrategySpecApplyConfiguration {
+	b.ClusterTimeout = &value
+	return b
+}
+
+// WithMaxFailures sets the MaxFailures field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MaxFailures field is set to the value of the last call.
+func (b *RemediationStrategySpecApplyConfiguration) WithMaxFailures(value intstr.IntOrString) *RemediationStrategySpecApplyConfiguration {
+	b.MaxFailures = &value
+	return b
+}
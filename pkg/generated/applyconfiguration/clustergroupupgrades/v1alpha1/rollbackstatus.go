@@ -0,0 +1,76 @@
+/*
+Copyright 2021.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// RollbackStatusApplyConfiguration represents an declarative configuration of the RollbackStatus type for use
+// with apply.
+type RollbackStatusApplyConfiguration struct {
+	StartedAt   *v1.Time                                    `json:"startedAt,omitempty"`
+	CompletedAt *v1.Time                                    `json:"completedAt,omitempty"`
+	Clusters    []string                                    `json:"clusters,omitempty"`
+	Policies    []ManagedPolicyForUpgradeApplyConfiguration `json:"policies,omitempty"`
+}
+
+// RollbackStatusApplyConfiguration constructs an declarative configuration of the RollbackStatus type for use with
+// apply.
+func RollbackStatus() *RollbackStatusApplyConfiguration {
+	return &RollbackStatusApplyConfiguration{}
+}
+
+// WithStartedAt sets the StartedAt field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the StartedAt field is set to the value of the last call.
+func (b *RollbackStatusApplyConfiguration) WithStartedAt(value v1.Time) *RollbackStatusApplyConfiguration {
+	b.StartedAt = &value
+	return b
+}
+
+// WithCompletedAt sets the CompletedAt field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the CompletedAt field is set to the value of the last call.
+func (b *RollbackStatusApplyConfiguration) WithCompletedAt(value v1.Time) *RollbackStatusApplyConfiguration {
+	b.CompletedAt = &value
+	return b
+}
+
+// WithClusters adds the given value to the Clusters field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Clusters field.
+func (b *RollbackStatusApplyConfiguration) WithClusters(values ...string) *RollbackStatusApplyConfiguration {
+	for i := range values {
+		b.Clusters = append(b.Clusters, values[i])
+	}
+	return b
+}
+
+// WithPolicies adds the given value to the Policies field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Policies field.
+func (b *RollbackStatusApplyConfiguration) WithPolicies(values ...*ManagedPolicyForUpgradeApplyConfiguration) *RollbackStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithPolicies")
+		}
+		b.Policies = append(b.Policies, *values[i])
+	}
+	return b
+}
@@ -25,11 +25,13 @@ import (
 // UpgradeStatusApplyConfiguration represents an declarative configuration of the UpgradeStatus type for use
 // with apply.
 type UpgradeStatusApplyConfiguration struct {
-	StartedAt                       *v1.Time                                        `json:"startedAt,omitempty"`
-	CompletedAt                     *v1.Time                                        `json:"completedAt,omitempty"`
-	CurrentBatch                    *int                                            `json:"currentBatch,omitempty"`
-	CurrentBatchStartedAt           *v1.Time                                        `json:"currentBatchStartedAt,omitempty"`
-	CurrentBatchRemediationProgress map[string]*v1alpha1.ClusterRemediationProgress `json:"currentBatchRemediationProgress,omitempty"`
+	StartedAt                        *v1.Time                                        `json:"startedAt,omitempty"`
+	CompletedAt                      *v1.Time                                        `json:"completedAt,omitempty"`
+	CurrentBatch                     *int                                            `json:"currentBatch,omitempty"`
+	CurrentBatchStartedAt            *v1.Time                                        `json:"currentBatchStartedAt,omitempty"`
+	PausedAt                         *v1.Time                                        `json:"pausedAt,omitempty"`
+	WaitingForMaintenanceWindowSince *v1.Time                                        `json:"waitingForMaintenanceWindowSince,omitempty"`
+	CurrentBatchRemediationProgress  map[string]*v1alpha1.ClusterRemediationProgress `json:"currentBatchRemediationProgress,omitempty"`
 }
 
 // UpgradeStatusApplyConfiguration constructs an declarative configuration of the UpgradeStatus type for use with
@@ -70,6 +72,22 @@ func (b *UpgradeStatusApplyConfiguration) WithCurrentBatchStartedAt(value v1.Tim
 	return b
 }
 
+// WithPausedAt sets the PausedAt field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the PausedAt field is set to the value of the last call.
+func (b *UpgradeStatusApplyConfiguration) WithPausedAt(value v1.Time) *UpgradeStatusApplyConfiguration {
+	b.PausedAt = &value
+	return b
+}
+
+// WithWaitingForMaintenanceWindowSince sets the WaitingForMaintenanceWindowSince field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the WaitingForMaintenanceWindowSince field is set to the value of the last call.
+func (b *UpgradeStatusApplyConfiguration) WithWaitingForMaintenanceWindowSince(value v1.Time) *UpgradeStatusApplyConfiguration {
+	b.WaitingForMaintenanceWindowSince = &value
+	return b
+}
+
 // WithCurrentBatchRemediationProgress puts the entries into the CurrentBatchRemediationProgress field in the declarative configuration
 // and returns the receiver, so that objects can be build by chaining "With" function invocations.
 // If called multiple times, the entries provided by each call will be put on the CurrentBatchRemediationProgress field,
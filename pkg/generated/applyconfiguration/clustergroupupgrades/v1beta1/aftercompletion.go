@@ -0,0 +1,90 @@
+/*
+Copyright 2021.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta1
+
+// AfterCompletionApplyConfiguration represents an declarative configuration of the AfterCompletion type for use
+// with apply.
+type AfterCompletionApplyConfiguration struct {
+	AddClusterLabels         map[string]string `json:"addClusterLabels,omitempty"`
+	RemoveClusterLabels      []string          `json:"removeClusterLabels,omitempty"`
+	AddClusterAnnotations    map[string]string `json:"addClusterAnnotations,omitempty"`
+	RemoveClusterAnnotations []string          `json:"removeClusterAnnotations,omitempty"`
+	DeleteObjects            *bool             `json:"deleteObjects,omitempty"`
+}
+
+// AfterCompletionApplyConfiguration constructs an declarative configuration of the AfterCompletion type for use with
+// apply.
+func AfterCompletion() *AfterCompletionApplyConfiguration {
+	return &AfterCompletionApplyConfiguration{}
+}
+
+// WithAddClusterLabels puts the entries into the AddClusterLabels field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, the entries provided by each call will be put on the AddClusterLabels field,
+// overwriting an existing map entries in AddClusterLabels field with the same key.
+func (b *AfterCompletionApplyConfiguration) WithAddClusterLabels(entries map[string]string) *AfterCompletionApplyConfiguration {
+	if b.AddClusterLabels == nil && len(entries) > 0 {
+		b.AddClusterLabels = make(map[string]string, len(entries))
+	}
+	for k, v := range entries {
+		b.AddClusterLabels[k] = v
+	}
+	return b
+}
+
+// WithRemoveClusterLabels adds the given value to the RemoveClusterLabels field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the RemoveClusterLabels field.
+func (b *AfterCompletionApplyConfiguration) WithRemoveClusterLabels(values ...string) *AfterCompletionApplyConfiguration {
+	for i := range values {
+		b.RemoveClusterLabels = append(b.RemoveClusterLabels, values[i])
+	}
+	return b
+}
+
+// WithAddClusterAnnotations puts the entries into the AddClusterAnnotations field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, the entries provided by each call will be put on the AddClusterAnnotations field,
+// overwriting an existing map entries in AddClusterAnnotations field with the same key.
+func (b *AfterCompletionApplyConfiguration) WithAddClusterAnnotations(entries map[string]string) *AfterCompletionApplyConfiguration {
+	if b.AddClusterAnnotations == nil && len(entries) > 0 {
+		b.AddClusterAnnotations = make(map[string]string, len(entries))
+	}
+	for k, v := range entries {
+		b.AddClusterAnnotations[k] = v
+	}
+	return b
+}
+
+// WithRemoveClusterAnnotations adds the given value to the RemoveClusterAnnotations field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the RemoveClusterAnnotations field.
+func (b *AfterCompletionApplyConfiguration) WithRemoveClusterAnnotations(values ...string) *AfterCompletionApplyConfiguration {
+	for i := range values {
+		b.RemoveClusterAnnotations = append(b.RemoveClusterAnnotations, values[i])
+	}
+	return b
+}
+
+// WithDeleteObjects sets the DeleteObjects field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the DeleteObjects field is set to the value of the last call.
+func (b *AfterCompletionApplyConfiguration) WithDeleteObjects(value bool) *AfterCompletionApplyConfiguration {
+	b.DeleteObjects = &value
+	return b
+}
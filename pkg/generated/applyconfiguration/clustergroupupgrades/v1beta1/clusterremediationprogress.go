@@ -0,0 +1,87 @@
+/*
+Copyright 2021.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ClusterRemediationProgressApplyConfiguration represents an declarative configuration of the ClusterRemediationProgress type for use
+// with apply.
+type ClusterRemediationProgressApplyConfiguration struct {
+	State             *string  `json:"state,omitempty"`
+	ManifestWorkIndex *int     `json:"manifestWorkIndex,omitempty"`
+	PolicyIndex       *int     `json:"policyIndex,omitempty"`
+	FirstCompliantAt  *v1.Time `json:"firstCompliantAt,omitempty"`
+	StartedAt         *v1.Time `json:"startedAt,omitempty"`
+	PolicyStartedAt   *v1.Time `json:"policyStartedAt,omitempty"`
+}
+
+// ClusterRemediationProgressApplyConfiguration constructs an declarative configuration of the ClusterRemediationProgress type for use with
+// apply.
+func ClusterRemediationProgress() *ClusterRemediationProgressApplyConfiguration {
+	return &ClusterRemediationProgressApplyConfiguration{}
+}
+
+// WithState sets the State field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the State field is set to the value of the last call.
+func (b *ClusterRemediationProgressApplyConfiguration) WithState(value string) *ClusterRemediationProgressApplyConfiguration {
+	b.State = &value
+	return b
+}
+
+// WithManifestWorkIndex sets the ManifestWorkIndex field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ManifestWorkIndex field is set to the value of the last call.
+func (b *ClusterRemediationProgressApplyConfiguration) WithManifestWorkIndex(value int) *ClusterRemediationProgressApplyConfiguration {
+	b.ManifestWorkIndex = &value
+	return b
+}
+
+// WithPolicyIndex sets the PolicyIndex field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the PolicyIndex field is set to the value of the last call.
+func (b *ClusterRemediationProgressApplyConfiguration) WithPolicyIndex(value int) *ClusterRemediationProgressApplyConfiguration {
+	b.PolicyIndex = &value
+	return b
+}
+
+// WithFirstCompliantAt sets the FirstCompliantAt field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the FirstCompliantAt field is set to the value of the last call.
+func (b *ClusterRemediationProgressApplyConfiguration) WithFirstCompliantAt(value v1.Time) *ClusterRemediationProgressApplyConfiguration {
+	b.FirstCompliantAt = &value
+	return b
+}
+
+// WithStartedAt sets the StartedAt field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the StartedAt field is set to the value of the last call.
+func (b *ClusterRemediationProgressApplyConfiguration) WithStartedAt(value v1.Time) *ClusterRemediationProgressApplyConfiguration {
+	b.StartedAt = &value
+	return b
+}
+
+// WithPolicyStartedAt sets the PolicyStartedAt field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the PolicyStartedAt field is set to the value of the last call.
+func (b *ClusterRemediationProgressApplyConfiguration) WithPolicyStartedAt(value v1.Time) *ClusterRemediationProgressApplyConfiguration {
+	b.PolicyStartedAt = &value
+	return b
+}
@@ -0,0 +1,70 @@
+/*
+Copyright 2021.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	v1beta1 "github.com/openshift-kni/cluster-group-upgrades-operator/pkg/api/clustergroupupgrades/v1beta1"
+	v1 "open-cluster-management.io/api/work/v1"
+)
+
+// ClusterRemediationStepApplyConfiguration represents an declarative configuration of the ClusterRemediationStep type for use
+// with apply.
+type ClusterRemediationStepApplyConfiguration struct {
+	Type               *v1beta1.RolloutType       `json:"type,omitempty"`
+	Name               *string                    `json:"name,omitempty"`
+	PolicyStatus       *string                    `json:"policyStatus,omitempty"`
+	ManifestWorkStatus *v1.ManifestResourceStatus `json:"manifestWorkStatus,omitempty"`
+}
+
+// ClusterRemediationStepApplyConfiguration constructs an declarative configuration of the ClusterRemediationStep type for use with
+// apply.
+func ClusterRemediationStep() *ClusterRemediationStepApplyConfiguration {
+	return &ClusterRemediationStepApplyConfiguration{}
+}
+
+// WithType sets the Type field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Type field is set to the value of the last call.
+func (b *ClusterRemediationStepApplyConfiguration) WithType(value v1beta1.RolloutType) *ClusterRemediationStepApplyConfiguration {
+	b.Type = &value
+	return b
+}
+
+// WithName sets the Name field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Name field is set to the value of the last call.
+func (b *ClusterRemediationStepApplyConfiguration) WithName(value string) *ClusterRemediationStepApplyConfiguration {
+	b.Name = &value
+	return b
+}
+
+// WithPolicyStatus sets the PolicyStatus field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the PolicyStatus field is set to the value of the last call.
+func (b *ClusterRemediationStepApplyConfiguration) WithPolicyStatus(value string) *ClusterRemediationStepApplyConfiguration {
+	b.PolicyStatus = &value
+	return b
+}
+
+// WithManifestWorkStatus sets the ManifestWorkStatus field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ManifestWorkStatus field is set to the value of the last call.
+func (b *ClusterRemediationStepApplyConfiguration) WithManifestWorkStatus(value v1.ManifestResourceStatus) *ClusterRemediationStepApplyConfiguration {
+	b.ManifestWorkStatus = &value
+	return b
+}
@@ -0,0 +1,69 @@
+/*
+Copyright 2021.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	v1beta1 "github.com/openshift-kni/cluster-group-upgrades-operator/pkg/api/clustergroupupgrades/v1beta1"
+)
+
+// ClusterStateApplyConfiguration represents an declarative configuration of the ClusterState type for use
+// with apply.
+type ClusterStateApplyConfiguration struct {
+	Name     *string                                   `json:"name,omitempty"`
+	State    *v1beta1.ClusterFinalState                `json:"state,omitempty"`
+	Reason   *string                                   `json:"reason,omitempty"`
+	LastStep *ClusterRemediationStepApplyConfiguration `json:"lastStep,omitempty"`
+}
+
+// ClusterStateApplyConfiguration constructs an declarative configuration of the ClusterState type for use with
+// apply.
+func ClusterState() *ClusterStateApplyConfiguration {
+	return &ClusterStateApplyConfiguration{}
+}
+
+// WithName sets the Name field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Name field is set to the value of the last call.
+func (b *ClusterStateApplyConfiguration) WithName(value string) *ClusterStateApplyConfiguration {
+	b.Name = &value
+	return b
+}
+
+// WithState sets the State field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the State field is set to the value of the last call.
+func (b *ClusterStateApplyConfiguration) WithState(value v1beta1.ClusterFinalState) *ClusterStateApplyConfiguration {
+	b.State = &value
+	return b
+}
+
+// WithReason sets the Reason field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Reason field is set to the value of the last call.
+func (b *ClusterStateApplyConfiguration) WithReason(value string) *ClusterStateApplyConfiguration {
+	b.Reason = &value
+	return b
+}
+
+// WithLastStep sets the LastStep field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the LastStep field is set to the value of the last call.
+func (b *ClusterStateApplyConfiguration) WithLastStep(value *ClusterRemediationStepApplyConfiguration) *ClusterStateApplyConfiguration {
+	b.LastStep = value
+	return b
+}
@@ -0,0 +1,69 @@
+/*
+Copyright 2021.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta1
+
+// DryRunClusterPlanApplyConfiguration represents an declarative configuration of the DryRunClusterPlan type for use
+// with apply.
+type DryRunClusterPlanApplyConfiguration struct {
+	Name                   *string  `json:"name,omitempty"`
+	BatchIndex             *int     `json:"batchIndex,omitempty"`
+	NonCompliantPolicies   []string `json:"nonCompliantPolicies,omitempty"`
+	SubscriptionsToApprove []string `json:"subscriptionsToApprove,omitempty"`
+}
+
+// DryRunClusterPlanApplyConfiguration constructs an declarative configuration of the DryRunClusterPlan type for use with
+// apply.
+func DryRunClusterPlan() *DryRunClusterPlanApplyConfiguration {
+	return &DryRunClusterPlanApplyConfiguration{}
+}
+
+// WithName sets the Name field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Name field is set to the value of the last call.
+func (b *DryRunClusterPlanApplyConfiguration) WithName(value string) *DryRunClusterPlanApplyConfiguration {
+	b.Name = &value
+	return b
+}
+
+// WithBatchIndex sets the BatchIndex field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the BatchIndex field is set to the value of the last call.
+func (b *DryRunClusterPlanApplyConfiguration) WithBatchIndex(value int) *DryRunClusterPlanApplyConfiguration {
+	b.BatchIndex = &value
+	return b
+}
+
+// WithNonCompliantPolicies adds the given value to the NonCompliantPolicies field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the NonCompliantPolicies field.
+func (b *DryRunClusterPlanApplyConfiguration) WithNonCompliantPolicies(values ...string) *DryRunClusterPlanApplyConfiguration {
+	for i := range values {
+		b.NonCompliantPolicies = append(b.NonCompliantPolicies, values[i])
+	}
+	return b
+}
+
+// WithSubscriptionsToApprove adds the given value to the SubscriptionsToApprove field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the SubscriptionsToApprove field.
+func (b *DryRunClusterPlanApplyConfiguration) WithSubscriptionsToApprove(values ...string) *DryRunClusterPlanApplyConfiguration {
+	for i := range values {
+		b.SubscriptionsToApprove = append(b.SubscriptionsToApprove, values[i])
+	}
+	return b
+}
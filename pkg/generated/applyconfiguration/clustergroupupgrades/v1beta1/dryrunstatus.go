@@ -0,0 +1,89 @@
+/*
+Copyright 2021.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// DryRunStatusApplyConfiguration represents an declarative configuration of the DryRunStatus type for use
+// with apply.
+type DryRunStatusApplyConfiguration struct {
+	ComputedAt        *v1.Time                              `json:"computedAt,omitempty"`
+	SelectedClusters  []string                              `json:"selectedClusters,omitempty"`
+	CompliantClusters []string                              `json:"compliantClusters,omitempty"`
+	Batches           [][]string                            `json:"batches,omitempty"`
+	Clusters          []DryRunClusterPlanApplyConfiguration `json:"clusters,omitempty"`
+}
+
+// DryRunStatusApplyConfiguration constructs an declarative configuration of the DryRunStatus type for use with
+// apply.
+func DryRunStatus() *DryRunStatusApplyConfiguration {
+	return &DryRunStatusApplyConfiguration{}
+}
+
+// WithComputedAt sets the ComputedAt field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ComputedAt field is set to the value of the last call.
+func (b *DryRunStatusApplyConfiguration) WithComputedAt(value v1.Time) *DryRunStatusApplyConfiguration {
+	b.ComputedAt = &value
+	return b
+}
+
+// WithSelectedClusters adds the given value to the SelectedClusters field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the SelectedClusters field.
+func (b *DryRunStatusApplyConfiguration) WithSelectedClusters(values ...string) *DryRunStatusApplyConfiguration {
+	for i := range values {
+		b.SelectedClusters = append(b.SelectedClusters, values[i])
+	}
+	return b
+}
+
+// WithCompliantClusters adds the given value to the CompliantClusters field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the CompliantClusters field.
+func (b *DryRunStatusApplyConfiguration) WithCompliantClusters(values ...string) *DryRunStatusApplyConfiguration {
+	for i := range values {
+		b.CompliantClusters = append(b.CompliantClusters, values[i])
+	}
+	return b
+}
+
+// WithBatches adds the given value to the Batches field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Batches field.
+func (b *DryRunStatusApplyConfiguration) WithBatches(values ...[]string) *DryRunStatusApplyConfiguration {
+	for i := range values {
+		b.Batches = append(b.Batches, values[i])
+	}
+	return b
+}
+
+// WithClusters adds the given value to the Clusters field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Clusters field.
+func (b *DryRunStatusApplyConfiguration) WithClusters(values ...*DryRunClusterPlanApplyConfiguration) *DryRunStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithClusters")
+		}
+		b.Clusters = append(b.Clusters, *values[i])
+	}
+	return b
+}
@@ -0,0 +1,71 @@
+/*
+Copyright 2021.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// MaintenanceWindowApplyConfiguration represents an declarative configuration of the MaintenanceWindow type for use
+// with apply.
+type MaintenanceWindowApplyConfiguration struct {
+	DaysOfWeek []string     `json:"daysOfWeek,omitempty"`
+	StartTime  *string      `json:"startTime,omitempty"`
+	Duration   *v1.Duration `json:"duration,omitempty"`
+	TimeZone   *string      `json:"timeZone,omitempty"`
+}
+
+// MaintenanceWindowApplyConfiguration constructs an declarative configuration of the MaintenanceWindow type for use with
+// apply.
+func MaintenanceWindow() *MaintenanceWindowApplyConfiguration {
+	return &MaintenanceWindowApplyConfiguration{}
+}
+
+// WithDaysOfWeek adds the given value to the DaysOfWeek field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the DaysOfWeek field.
+func (b *MaintenanceWindowApplyConfiguration) WithDaysOfWeek(values ...string) *MaintenanceWindowApplyConfiguration {
+	for i := range values {
+		b.DaysOfWeek = append(b.DaysOfWeek, values[i])
+	}
+	return b
+}
+
+// WithStartTime sets the StartTime field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the StartTime field is set to the value of the last call.
+func (b *MaintenanceWindowApplyConfiguration) WithStartTime(value string) *MaintenanceWindowApplyConfiguration {
+	b.StartTime = &value
+	return b
+}
+
+// WithDuration sets the Duration field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Duration field is set to the value of the last call.
+func (b *MaintenanceWindowApplyConfiguration) WithDuration(value v1.Duration) *MaintenanceWindowApplyConfiguration {
+	b.Duration = &value
+	return b
+}
+
+// WithTimeZone sets the TimeZone field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the TimeZone field is set to the value of the last call.
+func (b *MaintenanceWindowApplyConfiguration) WithTimeZone(value string) *MaintenanceWindowApplyConfiguration {
+	b.TimeZone = &value
+	return b
+}
@@ -0,0 +1,53 @@
+/*
+Copyright 2021.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta1
+
+// PrecachingStatusApplyConfiguration represents an declarative configuration of the PrecachingStatus type for use
+// with apply.
+type PrecachingStatusApplyConfiguration struct {
+	Spec   *PrecachingSpecApplyConfiguration `json:"spec,omitempty"`
+	Status map[string]string                 `json:"status,omitempty"`
+}
+
+// PrecachingStatusApplyConfiguration constructs an declarative configuration of the PrecachingStatus type for use with
+// apply.
+func PrecachingStatus() *PrecachingStatusApplyConfiguration {
+	return &PrecachingStatusApplyConfiguration{}
+}
+
+// WithSpec sets the Spec field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Spec field is set to the value of the last call.
+func (b *PrecachingStatusApplyConfiguration) WithSpec(value *PrecachingSpecApplyConfiguration) *PrecachingStatusApplyConfiguration {
+	b.Spec = value
+	return b
+}
+
+// WithStatus puts the entries into the Status field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, the entries provided by each call will be put on the Status field,
+// overwriting an existing map entries in Status field with the same key.
+func (b *PrecachingStatusApplyConfiguration) WithStatus(entries map[string]string) *PrecachingStatusApplyConfiguration {
+	if b.Status == nil && len(entries) > 0 {
+		b.Status = make(map[string]string, len(entries))
+	}
+	for k, v := range entries {
+		b.Status[k] = v
+	}
+	return b
+}
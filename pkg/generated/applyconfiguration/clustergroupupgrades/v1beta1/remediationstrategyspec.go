@@ -0,0 +1,147 @@
+/*
+Copyright 2021.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	intstr "k8s.io/apimachinery/pkg/util/intstr"
+)
+
+// RemediationStrategySpecApplyConfiguration represents an declarative configuration of the RemediationStrategySpec type for use
+// with apply.
+type RemediationStrategySpecApplyConfiguration struct {
+	Canaries            []string                                   `json:"canaries,omitempty"`
+	OnCanaryFailure     *string                                    `json:"onCanaryFailure,omitempty"`
+	RollbackPolicies    []string                                   `json:"rollbackPolicies,omitempty"`
+	Mode                *string                                    `json:"mode,omitempty"`
+	MaxConcurrency      *intstr.IntOrString                        `json:"maxConcurrency,omitempty"`
+	BatchSizes          []intstr.IntOrString                       `json:"batchSizes,omitempty"`
+	SpreadBy            *SpreadBySpecApplyConfiguration            `json:"spreadBy,omitempty"`
+	BatchBy             *string                                    `json:"batchBy,omitempty"`
+	UnavailableClusters *UnavailableClustersSpecApplyConfiguration `json:"unavailableClusters,omitempty"`
+	Timeout             *int                                       `json:"timeout,omitempty"`
+	ClusterTimeout      *int                                       `json:"clusterTimeout,omitempty"`
+	MaxFailures         *intstr.IntOrString                        `json:"maxFailures,omitempty"`
+}
+
+// RemediationStrategySpecApplyConfiguration constructs an declarative configuration of the RemediationStrategySpec type for use with
+// apply.
+func RemediationStrategySpec() *RemediationStrategySpecApplyConfiguration {
+	return &RemediationStrategySpecApplyConfiguration{}
+}
+
+// WithCanaries adds the given value to the Canaries field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Canaries field.
+func (b *RemediationStrategySpecApplyConfiguration) WithCanaries(values ...string) *RemediationStrategySpecApplyConfiguration {
+	for i := range values {
+		b.Canaries = append(b.Canaries, values[i])
+	}
+	return b
+}
+
+// WithOnCanaryFailure sets the OnCanaryFailure field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the OnCanaryFailure field is set to the value of the last call.
+func (b *RemediationStrategySpecApplyConfiguration) WithOnCanaryFailure(value string) *RemediationStrategySpecApplyConfiguration {
+	b.OnCanaryFailure = &value
+	return b
+}
+
+// WithRollbackPolicies adds the given value to the RollbackPolicies field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the RollbackPolicies field.
+func (b *RemediationStrategySpecApplyConfiguration) WithRollbackPolicies(values ...string) *RemediationStrategySpecApplyConfiguration {
+	for i := range values {
+		b.RollbackPolicies = append(b.RollbackPolicies, values[i])
+	}
+	return b
+}
+
+// WithMode sets the Mode field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Mode field is set to the value of the last call.
+func (b *RemediationStrategySpecApplyConfiguration) WithMode(value string) *RemediationStrategySpecApplyConfiguration {
+	b.Mode = &value
+	return b
+}
+
+// WithMaxConcurrency sets the MaxConcurrency field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MaxConcurrency field is set to the value of the last call.
+func (b *RemediationStrategySpecApplyConfiguration) WithMaxConcurrency(value intstr.IntOrString) *RemediationStrategySpecApplyConfiguration {
+	b.MaxConcurrency = &value
+	return b
+}
+
+// WithBatchSizes adds the given value to the BatchSizes field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the BatchSizes field.
+func (b *RemediationStrategySpecApplyConfiguration) WithBatchSizes(values ...intstr.IntOrString) *RemediationStrategySpecApplyConfiguration {
+	for i := range values {
+		b.BatchSizes = append(b.BatchSizes, values[i])
+	}
+	return b
+}
+
+// WithSpreadBy sets the SpreadBy field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SpreadBy field is set to the value of the last call.
+func (b *RemediationStrategySpecApplyConfiguration) WithSpreadBy(value *SpreadBySpecApplyConfiguration) *RemediationStrategySpecApplyConfiguration {
+	b.SpreadBy = value
+	return b
+}
+
+// WithBatchBy sets the BatchBy field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the BatchBy field is set to the value of the last call.
+func (b *RemediationStrategySpecApplyConfiguration) WithBatchBy(value string) *RemediationStrategySpecApplyConfiguration {
+	b.BatchBy = &value
+	return b
+}
+
+// WithUnavailableClusters sets the UnavailableClusters field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the UnavailableClusters field is set to the value of the last call.
+func (b *RemediationStrategySpecApplyConfiguration) WithUnavailableClusters(value *UnavailableClustersSpecApplyConfiguration) *RemediationStrategySpecApplyConfiguration {
+	b.UnavailableClusters = value
+	return b
+}
+
+// WithTimeout sets the Timeout field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Timeout field is set to the value of the last call.
+func (b *RemediationStrategySpecApplyConfiguration) WithTimeout(value int) *RemediationStrategySpecApplyConfiguration {
+	b.Timeout = &value
+	return b
+}
+
+// WithClusterTimeout sets the ClusterTimeout field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ClusterTimeout field is set to the value of the last call.
+func (b *RemediationStrategySpecApplyConfiguration) WithClusterTimeout(value int) *RemediationStrategySpecApplyConfiguration {
+	b.ClusterTimeout = &value
+	return b
+}
+
+// WithMaxFailures sets the MaxFailures field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MaxFailures field is set to the value of the last call.
+func (b *RemediationStrategySpecApplyConfiguration) WithMaxFailures(value intstr.IntOrString) *RemediationStrategySpecApplyConfiguration {
+	b.MaxFailures = &value
+	return b
+}
@@ -0,0 +1,67 @@
+/*
+Copyright 2021.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta1
+
+// RollingStatusApplyConfiguration represents an declarative configuration of the RollingStatus type for use
+// with apply.
+type RollingStatusApplyConfiguration struct {
+	InFlightClusters  []string `json:"inFlightClusters,omitempty"`
+	QueuedClusters    *int     `json:"queuedClusters,omitempty"`
+	CompletedClusters *int     `json:"completedClusters,omitempty"`
+	FailedClusters    *int     `json:"failedClusters,omitempty"`
+}
+
+// RollingStatusApplyConfiguration constructs an declarative configuration of the RollingStatus type for use with
+// apply.
+func RollingStatus() *RollingStatusApplyConfiguration {
+	return &RollingStatusApplyConfiguration{}
+}
+
+// WithInFlightClusters adds the given value to the InFlightClusters field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the InFlightClusters field.
+func (b *RollingStatusApplyConfiguration) WithInFlightClusters(values ...string) *RollingStatusApplyConfiguration {
+	for i := range values {
+		b.InFlightClusters = append(b.InFlightClusters, values[i])
+	}
+	return b
+}
+
+// WithQueuedClusters sets the QueuedClusters field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the QueuedClusters field is set to the value of the last call.
+func (b *RollingStatusApplyConfiguration) WithQueuedClusters(value int) *RollingStatusApplyConfiguration {
+	b.QueuedClusters = &value
+	return b
+}
+
+// WithCompletedClusters sets the CompletedClusters field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the CompletedClusters field is set to the value of the last call.
+func (b *RollingStatusApplyConfiguration) WithCompletedClusters(value int) *RollingStatusApplyConfiguration {
+	b.CompletedClusters = &value
+	return b
+}
+
+// WithFailedClusters sets the FailedClusters field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the FailedClusters field is set to the value of the last call.
+func (b *RollingStatusApplyConfiguration) WithFailedClusters(value int) *RollingStatusApplyConfiguration {
+	b.FailedClusters = &value
+	return b
+}
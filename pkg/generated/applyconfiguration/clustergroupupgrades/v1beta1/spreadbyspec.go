@@ -0,0 +1,47 @@
+/*
+Copyright 2021.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta1
+
+// SpreadBySpecApplyConfiguration represents an declarative configuration of the SpreadBySpec type for use
+// with apply.
+type SpreadBySpecApplyConfiguration struct {
+	LabelKey    *string `json:"labelKey,omitempty"`
+	MaxPerBatch *int    `json:"maxPerBatch,omitempty"`
+}
+
+// SpreadBySpecApplyConfiguration constructs an declarative configuration of the SpreadBySpec type for use with
+// apply.
+func SpreadBySpec() *SpreadBySpecApplyConfiguration {
+	return &SpreadBySpecApplyConfiguration{}
+}
+
+// WithLabelKey sets the LabelKey field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the LabelKey field is set to the value of the last call.
+func (b *SpreadBySpecApplyConfiguration) WithLabelKey(value string) *SpreadBySpecApplyConfiguration {
+	b.LabelKey = &value
+	return b
+}
+
+// WithMaxPerBatch sets the MaxPerBatch field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MaxPerBatch field is set to the value of the last call.
+func (b *SpreadBySpecApplyConfiguration) WithMaxPerBatch(value int) *SpreadBySpecApplyConfiguration {
+	b.MaxPerBatch = &value
+	return b
+}
@@ -0,0 +1,51 @@
+/*
+Copyright 2021.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// UnavailableClustersSpecApplyConfiguration represents an declarative configuration of the UnavailableClustersSpec type for use
+// with apply.
+type UnavailableClustersSpecApplyConfiguration struct {
+	Action      *string      `json:"action,omitempty"`
+	MaxLeaseAge *v1.Duration `json:"maxLeaseAge,omitempty"`
+}
+
+// UnavailableClustersSpecApplyConfiguration constructs an declarative configuration of the UnavailableClustersSpec type for use with
+// apply.
+func UnavailableClustersSpec() *UnavailableClustersSpecApplyConfiguration {
+	return &UnavailableClustersSpecApplyConfiguration{}
+}
+
+// WithAction sets the Action field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Action field is set to the value of the last call.
+func (b *UnavailableClustersSpecApplyConfiguration) WithAction(value string) *UnavailableClustersSpecApplyConfiguration {
+	b.Action = &value
+	return b
+}
+
+// WithMaxLeaseAge sets the MaxLeaseAge field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MaxLeaseAge field is set to the value of the last call.
+func (b *UnavailableClustersSpecApplyConfiguration) WithMaxLeaseAge(value v1.Duration) *UnavailableClustersSpecApplyConfiguration {
+	b.MaxLeaseAge = &value
+	return b
+}
@@ -19,7 +19,9 @@ package applyconfiguration
 
 import (
 	v1alpha1 "github.com/openshift-kni/cluster-group-upgrades-operator/pkg/api/clustergroupupgrades/v1alpha1"
+	v1beta1 "github.com/openshift-kni/cluster-group-upgrades-operator/pkg/api/clustergroupupgrades/v1beta1"
 	clustergroupupgradesv1alpha1 "github.com/openshift-kni/cluster-group-upgrades-operator/pkg/generated/applyconfiguration/clustergroupupgrades/v1alpha1"
+	clustergroupupgradesv1beta1 "github.com/openshift-kni/cluster-group-upgrades-operator/pkg/generated/applyconfiguration/clustergroupupgrades/v1beta1"
 	schema "k8s.io/apimachinery/pkg/runtime/schema"
 )
 
@@ -48,10 +50,24 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &clustergroupupgradesv1alpha1.ClusterRemediationProgressApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("ClusterState"):
 		return &clustergroupupgradesv1alpha1.ClusterStateApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("DryRunClusterPlan"):
+		return &clustergroupupgradesv1alpha1.DryRunClusterPlanApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("DryRunStatus"):
+		return &clustergroupupgradesv1alpha1.DryRunStatusApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("ExpectedCondition"):
+		return &clustergroupupgradesv1alpha1.ExpectedConditionApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("HealthChecksSpec"):
+		return &clustergroupupgradesv1alpha1.HealthChecksSpecApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("MaintenanceWindow"):
+		return &clustergroupupgradesv1alpha1.MaintenanceWindowApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("ManagedPolicyForUpgrade"):
 		return &clustergroupupgradesv1alpha1.ManagedPolicyForUpgradeApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("ManifestWorkStatus"):
 		return &clustergroupupgradesv1alpha1.ManifestWorkStatusApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("NamespacedCR"):
+		return &clustergroupupgradesv1alpha1.NamespacedCRApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("PolicyOverride"):
+		return &clustergroupupgradesv1alpha1.PolicyOverrideApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("PolicyStatus"):
 		return &clustergroupupgradesv1alpha1.PolicyStatusApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("PreCachingConfigCR"):
@@ -62,9 +78,79 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &clustergroupupgradesv1alpha1.PrecachingStatusApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("RemediationStrategySpec"):
 		return &clustergroupupgradesv1alpha1.RemediationStrategySpecApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("ResourceConditionCheck"):
+		return &clustergroupupgradesv1alpha1.ResourceConditionCheckApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("RollbackStatus"):
+		return &clustergroupupgradesv1alpha1.RollbackStatusApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("RollingStatus"):
+		return &clustergroupupgradesv1alpha1.RollingStatusApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("SpreadBySpec"):
+		return &clustergroupupgradesv1alpha1.SpreadBySpecApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("UnavailableClustersSpec"):
+		return &clustergroupupgradesv1alpha1.UnavailableClustersSpecApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("UpgradeStatus"):
 		return &clustergroupupgradesv1alpha1.UpgradeStatusApplyConfiguration{}
 
+		// Group=ran.openshift.io, Version=v1beta1
+	case v1beta1.SchemeGroupVersion.WithKind("Actions"):
+		return &clustergroupupgradesv1beta1.ActionsApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("AfterCompletion"):
+		return &clustergroupupgradesv1beta1.AfterCompletionApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("BackupStatus"):
+		return &clustergroupupgradesv1beta1.BackupStatusApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("BeforeEnable"):
+		return &clustergroupupgradesv1beta1.BeforeEnableApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("BlockingCR"):
+		return &clustergroupupgradesv1beta1.BlockingCRApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("ClusterGroupUpgrade"):
+		return &clustergroupupgradesv1beta1.ClusterGroupUpgradeApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("ClusterGroupUpgradeSpec"):
+		return &clustergroupupgradesv1beta1.ClusterGroupUpgradeSpecApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("ClusterGroupUpgradeStatus"):
+		return &clustergroupupgradesv1beta1.ClusterGroupUpgradeStatusApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("ClusterRemediationProgress"):
+		return &clustergroupupgradesv1beta1.ClusterRemediationProgressApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("ClusterRemediationStep"):
+		return &clustergroupupgradesv1beta1.ClusterRemediationStepApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("ClusterState"):
+		return &clustergroupupgradesv1beta1.ClusterStateApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("DryRunClusterPlan"):
+		return &clustergroupupgradesv1beta1.DryRunClusterPlanApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("DryRunStatus"):
+		return &clustergroupupgradesv1beta1.DryRunStatusApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("ExpectedCondition"):
+		return &clustergroupupgradesv1beta1.ExpectedConditionApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("HealthChecksSpec"):
+		return &clustergroupupgradesv1beta1.HealthChecksSpecApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("MaintenanceWindow"):
+		return &clustergroupupgradesv1beta1.MaintenanceWindowApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("ManagedPolicyForUpgrade"):
+		return &clustergroupupgradesv1beta1.ManagedPolicyForUpgradeApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("NamespacedCR"):
+		return &clustergroupupgradesv1beta1.NamespacedCRApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("PolicyOverride"):
+		return &clustergroupupgradesv1beta1.PolicyOverrideApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("PreCachingConfigCR"):
+		return &clustergroupupgradesv1beta1.PreCachingConfigCRApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("PrecachingSpec"):
+		return &clustergroupupgradesv1beta1.PrecachingSpecApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("PrecachingStatus"):
+		return &clustergroupupgradesv1beta1.PrecachingStatusApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("RemediationStrategySpec"):
+		return &clustergroupupgradesv1beta1.RemediationStrategySpecApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("ResourceConditionCheck"):
+		return &clustergroupupgradesv1beta1.ResourceConditionCheckApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("RollbackStatus"):
+		return &clustergroupupgradesv1beta1.RollbackStatusApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("RollingStatus"):
+		return &clustergroupupgradesv1beta1.RollingStatusApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("SpreadBySpec"):
+		return &clustergroupupgradesv1beta1.SpreadBySpecApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("UnavailableClustersSpec"):
+		return &clustergroupupgradesv1beta1.UnavailableClustersSpecApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("UpgradeStatus"):
+		return &clustergroupupgradesv1beta1.UpgradeStatusApplyConfiguration{}
+
 	}
 	return nil
 }
@@ -22,6 +22,7 @@ import (
 	"net/http"
 
 	ranv1alpha1 "github.com/openshift-kni/cluster-group-upgrades-operator/pkg/generated/clientset/versioned/typed/clustergroupupgrades/v1alpha1"
+	ranv1beta1 "github.com/openshift-kni/cluster-group-upgrades-operator/pkg/generated/clientset/versioned/typed/clustergroupupgrades/v1beta1"
 	discovery "k8s.io/client-go/discovery"
 	rest "k8s.io/client-go/rest"
 	flowcontrol "k8s.io/client-go/util/flowcontrol"
@@ -30,12 +31,14 @@ import (
 type Interface interface {
 	Discovery() discovery.DiscoveryInterface
 	RanV1alpha1() ranv1alpha1.RanV1alpha1Interface
+	RanV1beta1() ranv1beta1.RanV1beta1Interface
 }
 
 // Clientset contains the clients for groups.
 type Clientset struct {
 	*discovery.DiscoveryClient
 	ranV1alpha1 *ranv1alpha1.RanV1alpha1Client
+	ranV1beta1  *ranv1beta1.RanV1beta1Client
 }
 
 // RanV1alpha1 retrieves the RanV1alpha1Client
@@ -43,6 +46,11 @@ func (c *Clientset) RanV1alpha1() ranv1alpha1.RanV1alpha1Interface {
 	return c.ranV1alpha1
 }
 
+// RanV1beta1 retrieves the RanV1beta1Client
+func (c *Clientset) RanV1beta1() ranv1beta1.RanV1beta1Interface {
+	return c.ranV1beta1
+}
+
 // Discovery retrieves the DiscoveryClient
 func (c *Clientset) Discovery() discovery.DiscoveryInterface {
 	if c == nil {
@@ -91,6 +99,10 @@ func NewForConfigAndClient(c *rest.Config, httpClient *http.Client) (*Clientset,
 	if err != nil {
 		return nil, err
 	}
+	cs.ranV1beta1, err = ranv1beta1.NewForConfigAndClient(&configShallowCopy, httpClient)
+	if err != nil {
+		return nil, err
+	}
 
 	cs.DiscoveryClient, err = discovery.NewDiscoveryClientForConfigAndClient(&configShallowCopy, httpClient)
 	if err != nil {
@@ -113,6 +125,7 @@ func NewForConfigOrDie(c *rest.Config) *Clientset {
 func New(c rest.Interface) *Clientset {
 	var cs Clientset
 	cs.ranV1alpha1 = ranv1alpha1.New(c)
+	cs.ranV1beta1 = ranv1beta1.New(c)
 
 	cs.DiscoveryClient = discovery.NewDiscoveryClient(c)
 	return &cs
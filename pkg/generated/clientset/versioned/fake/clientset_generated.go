@@ -21,6 +21,8 @@ import (
 	clientset "github.com/openshift-kni/cluster-group-upgrades-operator/pkg/generated/clientset/versioned"
 	ranv1alpha1 "github.com/openshift-kni/cluster-group-upgrades-operator/pkg/generated/clientset/versioned/typed/clustergroupupgrades/v1alpha1"
 	fakeranv1alpha1 "github.com/openshift-kni/cluster-group-upgrades-operator/pkg/generated/clientset/versioned/typed/clustergroupupgrades/v1alpha1/fake"
+	ranv1beta1 "github.com/openshift-kni/cluster-group-upgrades-operator/pkg/generated/clientset/versioned/typed/clustergroupupgrades/v1beta1"
+	fakeranv1beta1 "github.com/openshift-kni/cluster-group-upgrades-operator/pkg/generated/clientset/versioned/typed/clustergroupupgrades/v1beta1/fake"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/watch"
 	"k8s.io/client-go/discovery"
@@ -82,3 +84,8 @@ var (
 func (c *Clientset) RanV1alpha1() ranv1alpha1.RanV1alpha1Interface {
 	return &fakeranv1alpha1.FakeRanV1alpha1{Fake: &c.Fake}
 }
+
+// RanV1beta1 retrieves the RanV1beta1Client
+func (c *Clientset) RanV1beta1() ranv1beta1.RanV1beta1Interface {
+	return &fakeranv1beta1.FakeRanV1beta1{Fake: &c.Fake}
+}
@@ -0,0 +1,89 @@
+/*
+Copyright 2021.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by informer-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	"context"
+	time "time"
+
+	clustergroupupgradesv1beta1 "github.com/openshift-kni/cluster-group-upgrades-operator/pkg/api/clustergroupupgrades/v1beta1"
+	versioned "github.com/openshift-kni/cluster-group-upgrades-operator/pkg/generated/clientset/versioned"
+	internalinterfaces "github.com/openshift-kni/cluster-group-upgrades-operator/pkg/generated/informers/externalversions/internalinterfaces"
+	v1beta1 "github.com/openshift-kni/cluster-group-upgrades-operator/pkg/generated/listers/clustergroupupgrades/v1beta1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+	watch "k8s.io/apimachinery/pkg/watch"
+	cache "k8s.io/client-go/tools/cache"
+)
+
+// ClusterGroupUpgradeInformer provides access to a shared informer and lister for
+// ClusterGroupUpgrades.
+type ClusterGroupUpgradeInformer interface {
+	Informer() cache.SharedIndexInformer
+	Lister() v1beta1.ClusterGroupUpgradeLister
+}
+
+type clusterGroupUpgradeInformer struct {
+	factory          internalinterfaces.SharedInformerFactory
+	tweakListOptions internalinterfaces.TweakListOptionsFunc
+	namespace        string
+}
+
+// NewClusterGroupUpgradeInformer constructs a new informer for ClusterGroupUpgrade type.
+// Always prefer using an informer factory to get a shared informer instead of getting an independent
+// one. This reduces memory footprint and number of connections to the server.
+func NewClusterGroupUpgradeInformer(client versioned.Interface, namespace string, resyncPeriod time.Duration, indexers cache.Indexers) cache.SharedIndexInformer {
+	return NewFilteredClusterGroupUpgradeInformer(client, namespace, resyncPeriod, indexers, nil)
+}
+
+// NewFilteredClusterGroupUpgradeInformer constructs a new informer for ClusterGroupUpgrade type.
+// Always prefer using an informer factory to get a shared informer instead of getting an independent
+// one. This reduces memory footprint and number of connections to the server.
+func NewFilteredClusterGroupUpgradeInformer(client versioned.Interface, namespace string, resyncPeriod time.Duration, indexers cache.Indexers, tweakListOptions internalinterfaces.TweakListOptionsFunc) cache.SharedIndexInformer {
+	return cache.NewSharedIndexInformer(
+		&cache.ListWatch{
+			ListFunc: func(options v1.ListOptions) (runtime.Object, error) {
+				if tweakListOptions != nil {
+					tweakListOptions(&options)
+				}
+				return client.RanV1beta1().ClusterGroupUpgrades(namespace).List(context.TODO(), options)
+			},
+			WatchFunc: func(options v1.ListOptions) (watch.Interface, error) {
+				if tweakListOptions != nil {
+					tweakListOptions(&options)
+				}
+				return client.RanV1beta1().ClusterGroupUpgrades(namespace).Watch(context.TODO(), options)
+			},
+		},
+		&clustergroupupgradesv1beta1.ClusterGroupUpgrade{},
+		resyncPeriod,
+		indexers,
+	)
+}
+
+func (f *clusterGroupUpgradeInformer) defaultInformer(client versioned.Interface, resyncPeriod time.Duration) cache.SharedIndexInformer {
+	return NewFilteredClusterGroupUpgradeInformer(client, f.namespace, resyncPeriod, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc}, f.tweakListOptions)
+}
+
+func (f *clusterGroupUpgradeInformer) Informer() cache.SharedIndexInformer {
+	return f.factory.InformerFor(&clustergroupupgradesv1beta1.ClusterGroupUpgrade{}, f.defaultInformer)
+}
+
+func (f *clusterGroupUpgradeInformer) Lister() v1beta1.ClusterGroupUpgradeLister {
+	return v1beta1.NewClusterGroupUpgradeLister(f.Informer().GetIndexer())
+}
@@ -21,6 +21,7 @@ import (
 	"fmt"
 
 	v1alpha1 "github.com/openshift-kni/cluster-group-upgrades-operator/pkg/api/clustergroupupgrades/v1alpha1"
+	v1beta1 "github.com/openshift-kni/cluster-group-upgrades-operator/pkg/api/clustergroupupgrades/v1beta1"
 	schema "k8s.io/apimachinery/pkg/runtime/schema"
 	cache "k8s.io/client-go/tools/cache"
 )
@@ -55,6 +56,10 @@ func (f *sharedInformerFactory) ForResource(resource schema.GroupVersionResource
 	case v1alpha1.SchemeGroupVersion.WithResource("clustergroupupgrades"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Ran().V1alpha1().ClusterGroupUpgrades().Informer()}, nil
 
+		// Group=ran.openshift.io, Version=v1beta1
+	case v1beta1.SchemeGroupVersion.WithResource("clustergroupupgrades"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Ran().V1beta1().ClusterGroupUpgrades().Informer()}, nil
+
 	}
 
 	return nil, fmt.Errorf("no informer found for %v", resource)